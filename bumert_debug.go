@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"time"
 )
 
 // getCallerInfo retrieves the file and line number of the caller.
@@ -25,10 +26,26 @@ func getCallerInfo(skipFrames int) string {
 	return fmt.Sprintf("%s:%d", file, line)
 }
 
-// failAssertion formats the error message with caller info and panics.
+// reportFailure panics with msg prefixed by callerInfo, unless a package
+// failure handler has been installed via SetFailureHandler, in which case
+// the formatted message is routed there instead. To route a single
+// chain's failures through a *testing.T without touching global state,
+// use For(tb).Should(v) instead of Should(v).
+func reportFailure(callerInfo, msg string) {
+	full := fmt.Sprintf("%s: assertion failed: %s", callerInfo, msg)
+	if h := failureHandler.Load(); h != nil {
+		(*h)(full)
+		return
+	}
+	panic(full)
+}
+
+// failAssertion formats the error message with caller info and reports it.
+// Used by the package-level Assert/Assertf, which have no *Assertion to
+// carry a testing.TB through.
 func failAssertion(format string, args ...any) {
 	callerInfo := getCallerInfo(2) // Skip failAssertion and the assertion method itself
-	panic(fmt.Sprintf("%s: assertion failed: %s", callerInfo, fmt.Sprintf(format, args...)))
+	reportFailure(callerInfo, fmt.Sprintf(format, args...))
 }
 
 // Should starts a fluent assertion chain for debug builds.
@@ -43,6 +60,90 @@ func Should(value any) *Assertion {
 // This struct is primarily used in debug builds.
 type Assertion struct {
 	value any
+
+	group      *Group      // set via Group.Should; failures are collected instead of panicking
+	diffConfig *DiffConfig // set via WithDiff; overrides DefaultDiffConfig for this chain only
+	negated    bool        // set via Not(); inverts the next terminal check, then clears itself
+}
+
+// Not inverts the next terminal check in the chain, the same way a
+// dedicated NotBeX method would, e.g. Should(x).Not().BeNil() instead of
+// Should(x).NotBeNil(). The inversion applies to a single terminal check
+// and is then cleared, so Not() must be re-applied before each negated
+// call. Terminal checks whose doc comment says "Honors Not()" invert
+// their result; EventuallyReturnTrue and EventuallyReceive clear the
+// flag without inverting anything.
+func (a *Assertion) Not() *Assertion {
+	a.negated = !a.negated
+	return a
+}
+
+// And is a no-op chaining aid for readability, letting a fluent chain
+// read like a sentence: Should(err).BeError().And().BeErrorWithMessage("timeout").
+// Every terminal check already returns the same *Assertion, so And()
+// exists purely for readability and has no effect on its own.
+func (a *Assertion) And() *Assertion {
+	return a
+}
+
+// consumeNegation reports whether Not() is in effect for the upcoming
+// terminal check, and clears it so it only applies once.
+func (a *Assertion) consumeNegation() bool {
+	n := a.negated
+	a.negated = false
+	return n
+}
+
+// diffConfigOrDefault returns the chain's per-call diff configuration set
+// via WithDiff, or the package-wide DefaultDiffConfig if none was set.
+func (a *Assertion) diffConfigOrDefault() DiffConfig {
+	if a.diffConfig != nil {
+		return *a.diffConfig
+	}
+	return DefaultDiffConfig
+}
+
+// WithDiff overrides how BeEqual renders its failure diff for this chain
+// only, without touching the package-wide DefaultDiffConfig. NotBeEqual,
+// HaveLen and Contain print dumps only, so differ is never called for them.
+// differ replaces the default dump-and-unified-diff rendering, e.g.
+// Should(x).WithDiff(cmp.Diff).BeEqual(y) to render failures with
+// google/go-cmp instead.
+func (a *Assertion) WithDiff(differ func(got, want any) string) *Assertion {
+	cfg := DefaultDiffConfig
+	cfg.Differ = differ
+	a.diffConfig = &cfg
+	return a
+}
+
+// fail reports a failure for this assertion chain. When the chain was
+// started via Group.Should, the failure is recorded on the group instead
+// of panicking immediately; otherwise it goes through reportFailure,
+// which panics unless a package failure handler (SetFailureHandler or
+// UseTestingT) is installed. To route a single chain's failures through
+// a *testing.T without touching global state, use For(tb).Should(v).
+func (a *Assertion) fail(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	// Skip fail and every *Assertion method above it -- the assertion
+	// method itself, plus a thin wrapper such as NotBeNil over BeNil -- so
+	// callerInfo names the caller's own line.
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+	self, _ := frames.Next()
+	prefix := strings.TrimSuffix(self.Function, "fail")
+	skip := 0
+	for f, more := frames.Next(); strings.HasPrefix(f.Function, prefix); f, more = frames.Next() {
+		skip++
+		if !more {
+			break
+		}
+	}
+	callerInfo := getCallerInfo(skip + 1)
+	if a.group != nil {
+		a.group.record(callerInfo, msg)
+		return
+	}
+	reportFailure(callerInfo, msg)
 }
 
 // isNil checks if the underlying value is nil using reflection.
@@ -65,29 +166,43 @@ func isNil(value any) bool {
 }
 
 // BeNil checks if the asserted value is nil.
-// Panics if the assertion fails in debug builds.
+// Panics if the assertion fails in debug builds. Honors Not(): Should(x).Not().BeNil()
+// is equivalent to NotBeNil().
 func (a *Assertion) BeNil() *Assertion {
-	if !isNil(a.value) {
-		failAssertion("should be nil: value (%#v) of type %T is not nil", a.value, a.value)
+	isN := isNil(a.value)
+	if a.consumeNegation() {
+		if isN {
+			a.fail("should not be nil: value is nil")
+		}
+		return a
+	}
+	if !isN {
+		a.fail("should be nil: value (%#v) of type %T is not nil", a.value, a.value)
 	}
 	return a
 }
 
 // NotBeNil checks if the asserted value is not nil.
-// Panics if the assertion fails in debug builds.
+// Panics if the assertion fails in debug builds. A thin wrapper over
+// Not().BeNil().
 func (a *Assertion) NotBeNil() *Assertion {
-	if isNil(a.value) {
-		failAssertion("should not be nil: value is nil")
-	}
-	return a
+	return a.Not().BeNil()
 }
 
 // TrueFn checks if the provided function returns true.
 // The original asserted value (from Should()) is ignored by this check.
-// Panics if the function returns false in debug builds.
+// Panics if the function returns false in debug builds. Honors Not():
+// Should(x).Not().TrueFn(f) fails when f returns true.
 func (a *Assertion) TrueFn(f func() bool) *Assertion {
-	if !f() {
-		failAssertion("function returned false")
+	pass := f()
+	if a.consumeNegation() {
+		if pass {
+			a.fail("function should have returned false, but returned true")
+		}
+		return a
+	}
+	if !pass {
+		a.fail("function returned false")
 	}
 	return a
 }
@@ -95,44 +210,72 @@ func (a *Assertion) TrueFn(f func() bool) *Assertion {
 // --- New Assertions ---
 
 // BeTrue checks if the asserted value is true.
-// Panics if the value is not boolean true.
+// Panics if the value is not boolean true. Honors Not(): Should(x).Not().BeTrue()
+// is equivalent to BeFalse() for booleans; unlike BeFalse(), it passes
+// non-bool values.
 func (a *Assertion) BeTrue() *Assertion {
-	if b, ok := a.value.(bool); !ok || !b {
-		failAssertion("should be true, but got: %#v (type %T)", a.value, a.value)
+	b, ok := a.value.(bool)
+	pass := ok && b
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be true, but got: %#v (type %T)", a.value, a.value)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be true, but got: %#v (type %T)", a.value, a.value)
 	}
 	return a
 }
 
 // BeFalse checks if the asserted value is false.
-// Panics if the value is not boolean false.
+// Panics if the value is not boolean false. Honors Not(): Should(x).Not().BeFalse()
+// is equivalent to BeTrue() for booleans; unlike BeTrue(), it passes
+// non-bool values.
 func (a *Assertion) BeFalse() *Assertion {
-	if b, ok := a.value.(bool); !ok || b {
-		failAssertion("should be false, but got: %#v (type %T)", a.value, a.value)
+	b, ok := a.value.(bool)
+	pass := ok && !b
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be false, but got: %#v (type %T)", a.value, a.value)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be false, but got: %#v (type %T)", a.value, a.value)
 	}
 	return a
 }
 
 // BeEqual checks if the asserted value is equal to the expected value.
 // Uses reflect.DeepEqual for comparison.
-// Panics if the values are not equal.
+// Panics if the values are not equal. Honors Not(): Should(x).Not().BeEqual(y)
+// is equivalent to NotBeEqual(y).
 func (a *Assertion) BeEqual(expected any) *Assertion {
-	if !reflect.DeepEqual(a.value, expected) {
-		failAssertion(`should be equal:
-  expected: %#v (type %T)
-       got: %#v (type %T)`,
-			expected, expected, a.value, a.value)
+	eq := reflect.DeepEqual(a.value, expected)
+	if a.consumeNegation() {
+		if eq {
+			a.fail("should not be equal, but got: %s", dump(a.value, a.diffConfigOrDefault()))
+		}
+		return a
+	}
+	if !eq {
+		cfg := a.diffConfigOrDefault()
+		msg := fmt.Sprintf("should be equal:\n  expected: %s\n       got: %s",
+			dump(expected, cfg), dump(a.value, cfg))
+		if diff := diffValues(expected, a.value, cfg); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s", msg)
 	}
 	return a
 }
 
 // NotBeEqual checks if the asserted value is not equal to the expected value.
 // Uses reflect.DeepEqual for comparison.
-// Panics if the values are equal.
+// Panics if the values are equal. A thin wrapper over Not().BeEqual().
 func (a *Assertion) NotBeEqual(unexpected any) *Assertion {
-	if reflect.DeepEqual(a.value, unexpected) {
-		failAssertion("should not be equal, but got: %#v (type %T)", a.value, a.value)
-	}
-	return a
+	return a.Not().BeEqual(unexpected)
 }
 
 // getLength returns the length of slice, array, map, chan, or string.
@@ -150,151 +293,208 @@ func getLength(value any) (int, bool) {
 	}
 }
 
+// beEmpty reports whether value is nil or has length zero, and whether it
+// was even length-testable (nil counts as empty regardless).
+func beEmpty(value any) (pass bool, lengthTestable bool) {
+	if isNil(value) {
+		return true, true
+	}
+	length, ok := getLength(value)
+	return ok && length == 0, ok
+}
+
 // BeEmpty checks if the asserted value is empty (e.g., nil, zero length slice/map/string).
-// Panics if the value is not empty.
+// Panics if the value is not empty. Honors Not(): Should(x).Not().BeEmpty()
+// is equivalent to NotBeEmpty().
 func (a *Assertion) BeEmpty() *Assertion {
-	if isNil(a.value) {
-		return a // nil is considered empty
-	}
-	if length, ok := getLength(a.value); ok {
-		if length == 0 {
-			return a // Zero length is empty
+	pass, testable := beEmpty(a.value)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be empty, but got: %#v", a.value)
 		}
-		failAssertion("should be empty, but got length %d: %#v", length, a.value)
-	} else {
-		// If we can't get length and it's not nil, consider it not empty
-		failAssertion("should be empty, but got non-nil, non-length-testable value: %#v (type %T)", a.value, a.value)
+		return a
+	}
+	if !testable {
+		a.fail("should be empty, but got non-nil, non-length-testable value: %#v (type %T)", a.value, a.value)
+		return a
+	}
+	if !pass {
+		length, _ := getLength(a.value)
+		a.fail("should be empty, but got length %d: %#v", length, a.value)
 	}
 	return a
 }
 
 // NotBeEmpty checks if the asserted value is not empty.
-// Panics if the value is empty.
+// Panics if the value is empty. A thin wrapper over Not().BeEmpty().
 func (a *Assertion) NotBeEmpty() *Assertion {
-	if isNil(a.value) {
-		failAssertion("should not be empty, but got nil")
-	}
-	if length, ok := getLength(a.value); ok && length == 0 {
-		failAssertion("should not be empty, but got zero length: %#v", a.value)
-	}
-	// If it's not nil and either has length > 0 or isn't length-testable, it's not empty
-	return a
+	return a.Not().BeEmpty()
 }
 
 // HaveLen checks if the asserted collection (slice, array, map, chan, string) has the expected length.
 // Panics if the value is not a collection type or if the length does not match.
+// Honors Not(): Should(x).Not().HaveLen(n) fails if x has length n, and
+// still fails a value that has no length at all.
 func (a *Assertion) HaveLen(expectedLen int) *Assertion {
-	if length, ok := getLength(a.value); ok {
-		if length != expectedLen {
-			failAssertion("should have length %d, but got %d: %#v", expectedLen, length, a.value)
+	length, ok := getLength(a.value)
+	if !ok {
+		a.consumeNegation()
+		a.fail("should have length, but got non-length-testable value: %#v (type %T)", a.value, a.value)
+		return a
+	}
+	pass := length == expectedLen
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not have length %d, but got: %s", expectedLen, dump(a.value, a.diffConfigOrDefault()))
 		}
-	} else {
-		failAssertion("should have length, but got non-length-testable value: %#v (type %T)", a.value, a.value)
+		return a
+	}
+	if !pass {
+		a.fail("should have length %d, but got %d: %s", expectedLen, length, dump(a.value, a.diffConfigOrDefault()))
 	}
 	return a
 }
 
-// Contain checks if the asserted slice, array, or string contains the expected element/substring.
-// For slices/arrays, it iterates and uses reflect.DeepEqual.
-// For strings, it uses strings.Contains.
-// Panics if the element/substring is not found or the type is unsupported.
-func (a *Assertion) Contain(expectedElement any) *Assertion {
-	val := reflect.ValueOf(a.value)
+// containsElement reports whether value (a string, slice, or array)
+// contains element. elementOK is false when value is a string and
+// element isn't itself a string. supported is false for any other kind.
+func containsElement(value, element any) (found bool, elementOK bool, supported bool) {
+	val := reflect.ValueOf(value)
 	switch val.Kind() {
 	case reflect.String:
-		sub, ok := expectedElement.(string)
+		sub, ok := element.(string)
 		if !ok {
-			failAssertion("Contain expected a string element for string value, got %T", expectedElement)
-		}
-		if !strings.Contains(val.String(), sub) {
-			failAssertion("string %#v should contain substring %#v", a.value, sub)
+			return false, false, true
 		}
+		return strings.Contains(val.String(), sub), true, true
 	case reflect.Slice, reflect.Array:
-		found := false
 		for i := 0; i < val.Len(); i++ {
-			if reflect.DeepEqual(val.Index(i).Interface(), expectedElement) {
-				found = true
-				break
+			if reflect.DeepEqual(val.Index(i).Interface(), element) {
+				return true, true, true
 			}
 		}
-		if !found {
-			failAssertion("collection %#v should contain element %#v", a.value, expectedElement)
-		}
-	// case reflect.Map: // Checking map containment is ambiguous (key or value?), omitting for now.
+		return false, true, true
 	default:
-		failAssertion("Contain requires slice, array, or string, got %T", a.value)
+		return false, true, false
 	}
-	return a
 }
 
-// NotContain checks if the asserted slice, array, or string does NOT contain the expected element/substring.
+// Contain checks if the asserted slice, array, or string contains the expected element/substring.
 // For slices/arrays, it iterates and uses reflect.DeepEqual.
 // For strings, it uses strings.Contains.
-// Panics if the element/substring IS found or the type is unsupported.
-func (a *Assertion) NotContain(unexpectedElement any) *Assertion {
-	val := reflect.ValueOf(a.value)
-	switch val.Kind() {
-	case reflect.String:
-		sub, ok := unexpectedElement.(string)
-		if !ok {
-			failAssertion("NotContain expected a string element for string value, got %T", unexpectedElement)
-		}
-		if strings.Contains(val.String(), sub) {
-			failAssertion("string %#v should not contain substring %#v", a.value, sub)
-		}
-	case reflect.Slice, reflect.Array:
-		found := false
-		for i := 0; i < val.Len(); i++ {
-			if reflect.DeepEqual(val.Index(i).Interface(), unexpectedElement) {
-				found = true
-				break
+// Panics if the element/substring is not found or the type is unsupported.
+// Honors Not(): Should(x).Not().Contain(e) is equivalent to NotContain(e).
+func (a *Assertion) Contain(expectedElement any) *Assertion {
+	found, elementOK, supported := containsElement(a.value, expectedElement)
+	if !supported {
+		a.consumeNegation()
+		a.fail("Contain requires slice, array, or string, got %T", a.value)
+		return a
+	}
+	if !elementOK {
+		a.consumeNegation()
+		a.fail("Contain expected a string element for string value, got %T", expectedElement)
+		return a
+	}
+	if _, isString := a.value.(string); isString {
+		sub := expectedElement.(string)
+		if a.consumeNegation() {
+			if found {
+				a.fail("string %#v should not contain substring %#v", a.value, sub)
 			}
+			return a
 		}
+		if !found {
+			a.fail("string %#v should contain substring %#v", a.value, sub)
+		}
+		return a
+	}
+	if a.consumeNegation() {
 		if found {
-			failAssertion("collection %#v should not contain element %#v", a.value, unexpectedElement)
+			a.fail("collection %s should not contain element %s", dump(a.value, a.diffConfigOrDefault()), dump(expectedElement, a.diffConfigOrDefault()))
 		}
-	// case reflect.Map: // Checking map containment is ambiguous (key or value?), omitting for now.
-	default:
-		failAssertion("NotContain requires slice, array, or string, got %T", a.value)
+		return a
+	}
+	if !found {
+		a.fail("collection %s should contain element %s", dump(a.value, a.diffConfigOrDefault()), dump(expectedElement, a.diffConfigOrDefault()))
 	}
 	return a
 }
 
+// NotContain checks if the asserted slice, array, or string does NOT contain the expected element/substring.
+// For slices/arrays, it iterates and uses reflect.DeepEqual.
+// For strings, it uses strings.Contains.
+// Panics if the element/substring IS found or the type is unsupported. A
+// thin wrapper over Not().Contain().
+func (a *Assertion) NotContain(unexpectedElement any) *Assertion {
+	return a.Not().Contain(unexpectedElement)
+}
+
 // ContainSubstring checks if the asserted string contains the expected substring.
 // Panics if the value is not a string or does not contain the substring.
+// Honors Not(): Should(x).Not().ContainSubstring(s) fails if x contains s.
 func (a *Assertion) ContainSubstring(substring string) *Assertion {
 	str, ok := a.value.(string)
 	if !ok {
-		failAssertion("ContainSubstring requires a string value, got %T", a.value)
+		a.consumeNegation()
+		a.fail("ContainSubstring requires a string value, got %T", a.value)
+		return a
+	}
+	pass := strings.Contains(str, substring)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("string %#v should not contain substring %#v", str, substring)
+		}
+		return a
 	}
-	if !strings.Contains(str, substring) {
-		failAssertion("string %#v should contain substring %#v", str, substring)
+	if !pass {
+		a.fail("string %#v should contain substring %#v", str, substring)
 	}
 	return a
 }
 
 // HavePrefix checks if the asserted string has the expected prefix.
 // Panics if the value is not a string or does not have the prefix.
+// Honors Not(): Should(x).Not().HavePrefix(p) fails if x has prefix p.
 func (a *Assertion) HavePrefix(prefix string) *Assertion {
 	str, ok := a.value.(string)
 	if !ok {
-		failAssertion("HavePrefix requires a string value, got %T", a.value)
+		a.consumeNegation()
+		a.fail("HavePrefix requires a string value, got %T", a.value)
+		return a
+	}
+	pass := strings.HasPrefix(str, prefix)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("string %#v should not have prefix %#v", str, prefix)
+		}
+		return a
 	}
-	if !strings.HasPrefix(str, prefix) {
-		failAssertion("string %#v should have prefix %#v", str, prefix)
+	if !pass {
+		a.fail("string %#v should have prefix %#v", str, prefix)
 	}
 	return a
 }
 
 // HaveSuffix checks if the asserted string has the expected suffix.
 // Panics if the value is not a string or does not have the suffix.
+// Honors Not(): Should(x).Not().HaveSuffix(s) fails if x has suffix s.
 func (a *Assertion) HaveSuffix(suffix string) *Assertion {
 	str, ok := a.value.(string)
 	if !ok {
-		failAssertion("HaveSuffix requires a string value, got %T", a.value)
+		a.consumeNegation()
+		a.fail("HaveSuffix requires a string value, got %T", a.value)
+		return a
+	}
+	pass := strings.HasSuffix(str, suffix)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("string %#v should not have suffix %#v", str, suffix)
+		}
+		return a
 	}
-	if !strings.HasSuffix(str, suffix) {
-		failAssertion("string %#v should have suffix %#v", str, suffix)
+	if !pass {
+		a.fail("string %#v should have suffix %#v", str, suffix)
 	}
 	return a
 }
@@ -310,29 +510,68 @@ func isZero(value any) bool {
 }
 
 // BeZero checks if the asserted value is the zero value for its type (e.g., 0, "", false, nil pointer).
-// Panics if the value is not the zero value.
+// Panics if the value is not the zero value. Honors Not(): Should(x).Not().BeZero()
+// is equivalent to NotBeZero().
 func (a *Assertion) BeZero() *Assertion {
-	if !isZero(a.value) {
-		failAssertion("should be the zero value, but got: %#v (type %T)", a.value, a.value)
+	isZ := isZero(a.value)
+	if a.consumeNegation() {
+		if isZ {
+			a.fail("should not be the zero value, but got: %#v (type %T)", a.value, a.value)
+		}
+		return a
+	}
+	if !isZ {
+		a.fail("should be the zero value, but got: %#v (type %T)", a.value, a.value)
 	}
 	return a
 }
 
 // NotBeZero checks if the asserted value is NOT the zero value for its type.
-// Panics if the value is the zero value.
+// Panics if the value is the zero value. A thin wrapper over Not().BeZero().
 func (a *Assertion) NotBeZero() *Assertion {
-	if isZero(a.value) {
-		failAssertion("should not be the zero value, but got: %#v (type %T)", a.value, a.value)
+	return a.Not().BeZero()
+}
+
+// compareOp applies op (one of ">", "<", ">=", "<=") to a pre-computed
+// less-than/equal pair, so every supported kind can share one switch.
+func compareOp(less, equal bool, op string) bool {
+	switch op {
+	case ">":
+		return !less && !equal
+	case "<":
+		return less
+	case ">=":
+		return !less
+	case "<=":
+		return less || equal
+	default:
+		return false
 	}
-	return a
 }
 
-// compare performs numeric comparison (>, <, >=, <=) using reflection.
+// compare performs ordered comparison (>, <, >=, <=) using reflection.
+// In addition to numeric kinds it supports strings (lexicographic) and
+// time.Time (via Before/After).
 // Returns comparison result and true if comparable, false otherwise.
 // op should be one of: ">", "<", ">=", "<=".
 func compare(v1, v2 reflect.Value, op string) (bool, bool) {
+	if v1.IsValid() && v2.IsValid() {
+		if t1, ok1 := v1.Interface().(time.Time); ok1 {
+			t2, ok2 := v2.Interface().(time.Time)
+			if !ok2 {
+				return false, false
+			}
+			return compareOp(t1.Before(t2), t1.Equal(t2), op), true
+		}
+	}
+
 	k1, k2 := v1.Kind(), v2.Kind()
 
+	if k1 == reflect.String && k2 == reflect.String {
+		s1, s2 := v1.String(), v2.String()
+		return compareOp(s1 < s2, s1 == s2, op), true
+	}
+
 	// Handle direct comparison for identical types first
 	if v1.Type() == v2.Type() {
 		switch k1 {
@@ -422,95 +661,165 @@ func convertToFloat64(v reflect.Value) (float64, bool) {
 }
 
 // BeGreaterThan checks if the asserted numeric value is greater than the expected value.
-// Panics if types are not comparable or the assertion fails.
+// Panics if types are not comparable or the assertion fails. Honors
+// Not(): Should(x).Not().BeGreaterThan(y) fails if x is greater than y.
 func (a *Assertion) BeGreaterThan(expected any) *Assertion {
 	v1, v2 := reflect.ValueOf(a.value), reflect.ValueOf(expected)
 	result, ok := compare(v1, v2, ">")
 	if !ok {
-		failAssertion("BeGreaterThan requires comparable numeric types, got %T and %T", a.value, expected)
+		a.consumeNegation()
+		a.fail("BeGreaterThan requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if a.consumeNegation() {
+		if result {
+			a.fail("should not be greater than %#v, but got %#v", expected, a.value)
+		}
+		return a
 	}
 	if !result {
-		failAssertion("should be greater than %#v, but got %#v", expected, a.value)
+		a.fail("should be greater than %#v, but got %#v", expected, a.value)
 	}
 	return a
 }
 
 // BeLessThan checks if the asserted numeric value is less than the expected value.
-// Panics if types are not comparable or the assertion fails.
+// Panics if types are not comparable or the assertion fails. Honors
+// Not(): Should(x).Not().BeLessThan(y) fails if x is less than y.
 func (a *Assertion) BeLessThan(expected any) *Assertion {
 	v1, v2 := reflect.ValueOf(a.value), reflect.ValueOf(expected)
 	result, ok := compare(v1, v2, "<")
 	if !ok {
-		failAssertion("BeLessThan requires comparable numeric types, got %T and %T", a.value, expected)
+		a.consumeNegation()
+		a.fail("BeLessThan requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if a.consumeNegation() {
+		if result {
+			a.fail("should not be less than %#v, but got %#v", expected, a.value)
+		}
+		return a
 	}
 	if !result {
-		failAssertion("should be less than %#v, but got %#v", expected, a.value)
+		a.fail("should be less than %#v, but got %#v", expected, a.value)
 	}
 	return a
 }
 
 // BeGreaterThanOrEqualTo checks if the asserted numeric value is greater than or equal to the expected value.
-// Panics if types are not comparable or the assertion fails.
+// Panics if types are not comparable or the assertion fails. Honors
+// Not(): Should(x).Not().BeGreaterThanOrEqualTo(y) fails if x >= y.
 func (a *Assertion) BeGreaterThanOrEqualTo(expected any) *Assertion {
 	v1, v2 := reflect.ValueOf(a.value), reflect.ValueOf(expected)
 	result, ok := compare(v1, v2, ">=")
 	if !ok {
-		failAssertion("BeGreaterThanOrEqualTo requires comparable numeric types, got %T and %T", a.value, expected)
+		a.consumeNegation()
+		a.fail("BeGreaterThanOrEqualTo requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if a.consumeNegation() {
+		if result {
+			a.fail("should not be greater than or equal to %#v, but got %#v", expected, a.value)
+		}
+		return a
 	}
 	if !result {
-		failAssertion("should be greater than or equal to %#v, but got %#v", expected, a.value)
+		a.fail("should be greater than or equal to %#v, but got %#v", expected, a.value)
 	}
 	return a
 }
 
 // BeLessThanOrEqualTo checks if the asserted numeric value is less than or equal to the expected value.
-// Panics if types are not comparable or the assertion fails.
+// Panics if types are not comparable or the assertion fails. Honors
+// Not(): Should(x).Not().BeLessThanOrEqualTo(y) fails if x <= y.
 func (a *Assertion) BeLessThanOrEqualTo(expected any) *Assertion {
 	v1, v2 := reflect.ValueOf(a.value), reflect.ValueOf(expected)
 	result, ok := compare(v1, v2, "<=")
 	if !ok {
-		failAssertion("BeLessThanOrEqualTo requires comparable numeric types, got %T and %T", a.value, expected)
+		a.consumeNegation()
+		a.fail("BeLessThanOrEqualTo requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if a.consumeNegation() {
+		if result {
+			a.fail("should not be less than or equal to %#v, but got %#v", expected, a.value)
+		}
+		return a
 	}
 	if !result {
-		failAssertion("should be less than or equal to %#v, but got %#v", expected, a.value)
+		a.fail("should be less than or equal to %#v, but got %#v", expected, a.value)
+	}
+	return a
+}
+
+// BeBetween checks if the asserted value falls within [lo, hi], inclusive
+// on both ends, using the same ordering compare() uses for
+// BeGreaterThan/BeLessThan (numeric kinds, strings, and time.Time).
+// Panics if types are not comparable or the value falls outside the
+// range. Honors Not(): Should(x).Not().BeBetween(lo, hi) fails if x
+// falls within [lo, hi].
+func (a *Assertion) BeBetween(lo, hi any) *Assertion {
+	v, loVal, hiVal := reflect.ValueOf(a.value), reflect.ValueOf(lo), reflect.ValueOf(hi)
+	geLo, okLo := compare(v, loVal, ">=")
+	leHi, okHi := compare(v, hiVal, "<=")
+	if !okLo || !okHi {
+		a.consumeNegation()
+		a.fail("BeBetween requires comparable numeric types, got %T, %T and %T", a.value, lo, hi)
+		return a
+	}
+	within := geLo && leHi
+	if a.consumeNegation() {
+		if within {
+			a.fail("should not be between %#v and %#v, but got %#v", lo, hi, a.value)
+		}
+		return a
+	}
+	if !within {
+		a.fail("should be between %#v and %#v, but got %#v", lo, hi, a.value)
 	}
 	return a
 }
 
 // BeError checks if the asserted value is an error (i.e., implements the error interface and is not nil).
-// Panics if the value is not a non-nil error.
+// Panics if the value is not a non-nil error. Honors Not():
+// Should(x).Not().BeError() is equivalent to NotBeError().
 func (a *Assertion) BeError() *Assertion {
-	if isNil(a.value) {
-		failAssertion("should be an error, but got nil")
+	_, isErr := a.value.(error)
+	pass := isErr && !isNil(a.value)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be an error, but got: %v", a.value)
+		}
+		return a
 	}
-	if _, ok := a.value.(error); !ok {
-		failAssertion("should be an error, but got type %T with value %#v", a.value, a.value)
+	if !pass {
+		if isNil(a.value) {
+			a.fail("should be an error, but got nil")
+		} else {
+			a.fail("should be an error, but got type %T with value %#v", a.value, a.value)
+		}
 	}
-	// It is an error and it's not nil
 	return a
 }
 
 // NotBeError checks if the asserted value is nil or not an error.
 // Typically used to assert that an error variable is nil.
-// Panics if the value is a non-nil error.
+// Panics if the value is a non-nil error. A thin wrapper over Not().BeError().
 func (a *Assertion) NotBeError() *Assertion {
-	if !isNil(a.value) {
-		if err, ok := a.value.(error); ok {
-			failAssertion("should not be an error, but got: %v", err)
-		}
-		// It's not nil, but also not an error type, which is acceptable for NotBeError.
-	}
-	// Value is nil or not an error type
-	return a
+	return a.Not().BeError()
 }
 
 // BeErrorOfType checks if the asserted value is an error that matches the type of the target.
 // target must be a pointer to a variable of the desired error type (e.g., var target *os.PathError).
 // Panics if the value is not an error or does not match the target type.
+// Honors Not(): Should(x).Not().BeErrorOfType(&target) fails if x matches
+// target's type.
 func (a *Assertion) BeErrorOfType(target any) *Assertion {
 	err, ok := a.value.(error)
 	if !ok || isNil(err) {
-		failAssertion("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		a.consumeNegation()
+		a.fail("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		return a
 	}
 
 	// Validate the target: must be a non-nil pointer for errors.As.
@@ -521,7 +830,14 @@ func (a *Assertion) BeErrorOfType(target any) *Assertion {
 		panic(fmt.Sprintf("internal bumert error: BeErrorOfType target must be a non-nil pointer, got %T", target))
 	}
 
-	if !errors.As(err, target) {
+	matches := errors.As(err, target)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("error type should not be assignable from %T, but got: %v", err, err)
+		}
+		return a
+	}
+	if !matches {
 		// Attempt to get a meaningful type name for the error message.
 		// This is tricky because target could be *MyError, **MyError, *error, etc.
 		targetTypeName := "unknown (check target type)"
@@ -531,21 +847,115 @@ func (a *Assertion) BeErrorOfType(target any) *Assertion {
 			targetTypeName = targetVal.Type().Elem().Elem().String() // e.g., "fs.PathError" for **fs.PathError
 		}
 
-		failAssertion("error type should be %s (or wrap it), but got type %T: %v", targetTypeName, err, err)
+		a.fail("error type should be %s (or wrap it), but got type %T: %v", targetTypeName, err, err)
+	}
+	return a
+}
+
+// BeErrorIs checks if the asserted value is a non-nil error that matches
+// target per errors.Is semantics, unwrapping through fmt.Errorf("%w", ...)
+// and Unwrap() chains to find it.
+// Panics if the value is not an error or does not match target. Honors
+// Not(): Should(x).Not().BeErrorIs(target) fails if x's chain matches
+// target; unlike NotBeErrorIs(target), it still fails a nil or non-error
+// value.
+func (a *Assertion) BeErrorIs(target error) *Assertion {
+	err, ok := a.value.(error)
+	if !ok || isNil(err) {
+		a.consumeNegation()
+		a.fail("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		return a
+	}
+
+	matches := errors.Is(err, target)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("error chain should not match target %+v, but got: %+v", target, err)
+		}
+		return a
+	}
+	if !matches {
+		a.fail("error chain should match target %+v, but got: %+v", target, err)
+	}
+	return a
+}
+
+// NotBeErrorIs checks if the asserted value is nil, not an error, or a
+// non-nil error whose chain does not match target per errors.Is semantics.
+// Panics if the value is a non-nil error that matches target. A thin
+// wrapper over Not().BeErrorIs(), except that a nil or non-error value
+// passes here (there's nothing to not-match), unlike BeErrorIs itself
+// which requires a non-nil error.
+func (a *Assertion) NotBeErrorIs(target error) *Assertion {
+	if err, ok := a.value.(error); ok && !isNil(err) {
+		return a.Not().BeErrorIs(target)
+	}
+	a.consumeNegation()
+	return a
+}
+
+// BeErrorAs checks if the asserted value is a non-nil error whose chain
+// contains an error matching target per errors.As semantics, unwrapping
+// through fmt.Errorf("%w", ...) and Unwrap() chains. target must be a
+// non-nil pointer to either an interface type or a type implementing
+// error (e.g., var target *os.PathError). On success, target is
+// populated with the matched error so its fields can be inspected.
+// Panics if the value is not an error, target is not a suitable pointer,
+// or no error in the chain matches target.
+// Honors Not(): Should(x).Not().BeErrorAs(&target) fails if x's chain
+// contains a match.
+func (a *Assertion) BeErrorAs(target any) *Assertion {
+	err, ok := a.value.(error)
+	if !ok || isNil(err) {
+		a.consumeNegation()
+		a.fail("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		return a
+	}
+
+	targetVal := reflect.ValueOf(target)
+	if targetVal.Kind() != reflect.Ptr || targetVal.IsNil() {
+		panic(fmt.Sprintf("internal bumert error: BeErrorAs target must be a non-nil pointer, got %T", target))
+	}
+
+	matches := errors.As(err, target)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("error chain should not contain a match, but got chain: %+v", err)
+		}
+		return a
+	}
+	if !matches {
+		targetTypeName := targetVal.Type().Elem().String() // e.g., "error" for *error
+		if targetVal.Type().Elem().Kind() == reflect.Ptr {
+			targetTypeName = targetVal.Type().Elem().Elem().String() // e.g., "fs.PathError" for **fs.PathError
+		}
+
+		a.fail("error chain should contain type %s, but got chain: %+v", targetTypeName, err)
 	}
 	return a
 }
 
 // BeErrorWithMessage checks if the asserted value is a non-nil error whose message contains the expected substring.
-// Panics if the value is not an error or the message does not contain the substring.
+// Panics if the value is not an error or the message does not contain the
+// substring. Honors Not(): Should(x).Not().BeErrorWithMessage(s) fails if
+// x's message contains s.
 func (a *Assertion) BeErrorWithMessage(substring string) *Assertion {
 	err, ok := a.value.(error)
 	if !ok || isNil(err) {
-		failAssertion("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		a.consumeNegation()
+		a.fail("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		return a
 	}
 	message := err.Error()
-	if !strings.Contains(message, substring) {
-		failAssertion("error message %#v should contain substring %#v", message, substring)
+	pass := strings.Contains(message, substring)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("error message %#v should not contain substring %#v", message, substring)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("error message %#v should contain substring %#v", message, substring)
 	}
 	return a
 }
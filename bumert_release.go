@@ -3,6 +3,11 @@
 
 package bumert
 
+import (
+	"context"
+	"time"
+)
+
 // Assertion is a no-op struct in release builds.
 type Assertion struct{}
 
@@ -16,6 +21,16 @@ func Should(value any) *Assertion {
 // A singleton instance for the no-op assertion.
 var noOpAssertion Assertion
 
+// Not is a no-op method in release builds.
+func (a *Assertion) Not() *Assertion {
+	return a // Return receiver for chainability
+}
+
+// And is a no-op method in release builds.
+func (a *Assertion) And() *Assertion {
+	return a // Return receiver for chainability
+}
+
 // BeNil is a no-op method in release builds.
 func (a *Assertion) BeNil() *Assertion {
 	return a // Return receiver for chainability
@@ -31,6 +46,11 @@ func (a *Assertion) TrueFn(f func() bool) *Assertion {
 	return a // Return receiver for chainability
 }
 
+// WithDiff is a no-op method in release builds.
+func (a *Assertion) WithDiff(differ func(got, want any) string) *Assertion {
+	return a // Return receiver for chainability
+}
+
 // BeTrue is a no-op method in release builds.
 func (a *Assertion) BeTrue() *Assertion {
 	return a // Return receiver for chainability
@@ -121,6 +141,11 @@ func (a *Assertion) BeLessThanOrEqualTo(expected any) *Assertion {
 	return a // Return receiver for chainability
 }
 
+// BeBetween is a no-op method in release builds.
+func (a *Assertion) BeBetween(lo, hi any) *Assertion {
+	return a // Return receiver for chainability
+}
+
 // BeError is a no-op method in release builds.
 func (a *Assertion) BeError() *Assertion {
 	return a // Return receiver for chainability
@@ -136,11 +161,151 @@ func (a *Assertion) BeErrorOfType(target any) *Assertion {
 	return a // Return receiver for chainability
 }
 
+// BeErrorIs is a no-op method in release builds.
+func (a *Assertion) BeErrorIs(target error) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// NotBeErrorIs is a no-op method in release builds.
+func (a *Assertion) NotBeErrorIs(target error) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeErrorAs is a no-op method in release builds.
+func (a *Assertion) BeErrorAs(target any) *Assertion {
+	return a // Return receiver for chainability
+}
+
 // BeErrorWithMessage is a no-op method in release builds.
 func (a *Assertion) BeErrorWithMessage(substring string) *Assertion {
 	return a // Return receiver for chainability
 }
 
+// BeInDelta is a no-op method in release builds.
+func (a *Assertion) BeInDelta(expected float64, delta float64) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeInEpsilon is a no-op method in release builds.
+func (a *Assertion) BeInEpsilon(expected float64, epsilon float64) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeWithinDelta is a no-op method in release builds.
+func (a *Assertion) BeWithinDelta(expected any, delta float64) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeWithinEpsilon is a no-op method in release builds.
+func (a *Assertion) BeWithinEpsilon(expected any, epsilon float64) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// MatchRegexp is a no-op method in release builds.
+func (a *Assertion) MatchRegexp(pattern any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// NotMatchRegexp is a no-op method in release builds.
+func (a *Assertion) NotMatchRegexp(pattern any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// Implement is a no-op method in release builds.
+func (a *Assertion) Implement(ifacePtr any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeAssignableTo is a no-op method in release builds.
+func (a *Assertion) BeAssignableTo(target any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeJSONEqual is a no-op method in release builds.
+func (a *Assertion) BeJSONEqual(expectedJSON string) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeYAMLEqual is a no-op method in release builds.
+func (a *Assertion) BeYAMLEqual(expectedYAML string) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeWithinDuration is a no-op method in release builds.
+func (a *Assertion) BeWithinDuration(expected time.Time, delta time.Duration) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeElementsMatch is a no-op method in release builds.
+func (a *Assertion) BeElementsMatch(expected any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// ContainKey is a no-op method in release builds.
+func (a *Assertion) ContainKey(key any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// NotContainKey is a no-op method in release builds.
+func (a *Assertion) NotContainKey(key any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// ContainValue is a no-op method in release builds.
+func (a *Assertion) ContainValue(value any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// HaveKeyWithValue is a no-op method in release builds.
+func (a *Assertion) HaveKeyWithValue(key, value any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// Eventually is a no-op function in release builds.
+func Eventually(cond func() bool, timeout, interval time.Duration) {
+	// No-op
+}
+
+// Never is a no-op function in release builds.
+func Never(cond func() bool, duration, interval time.Duration) {
+	// No-op
+}
+
+// EventuallyCtx is a no-op function in release builds.
+func EventuallyCtx(ctx context.Context, cond func() bool, interval time.Duration) {
+	// No-op
+}
+
+// EventuallyReturnTrue is a no-op method in release builds.
+func (a *Assertion) EventuallyReturnTrue(timeout, interval time.Duration) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// EventuallyReceive is a no-op method in release builds.
+func (a *Assertion) EventuallyReceive(timeout time.Duration) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// Panic is a no-op method in release builds.
+func (a *Assertion) Panic() *Assertion {
+	return a // Return receiver for chainability
+}
+
+// NotPanic is a no-op method in release builds.
+func (a *Assertion) NotPanic() *Assertion {
+	return a // Return receiver for chainability
+}
+
+// PanicWithValue is a no-op method in release builds.
+func (a *Assertion) PanicWithValue(expected any) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// PanicWithError is a no-op method in release builds.
+func (a *Assertion) PanicWithError(substr string) *Assertion {
+	return a // Return receiver for chainability
+}
+
 // Assert is a no-op function in release builds.
 func Assert(condition bool) {
 	// No-op
@@ -6,38 +6,41 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/deblasis/bumert"
 )
 
+// refTime is a fixed reference instant used by the ordering-assertion
+// tests so Time comparisons don't depend on the wall clock.
+var refTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 // assertPanics asserts that the given function f panics with a message containing expectedSubstring.
 func assertPanics(t *testing.T, f func(), expectedSubstring string) {
 	t.Helper()
-	defer func() {
-		recovered := recover()
-		if recovered == nil {
-			t.Errorf("Expected function to panic, but it did not")
-			return
-		}
-		panicMsg := fmt.Sprintf("%v", recovered)
-		if expectedSubstring != "" && !strings.Contains(panicMsg, expectedSubstring) {
-			t.Errorf("Panic message did not contain expected substring.\nExpected: %q\nActual:   %q", expectedSubstring, panicMsg)
-		}
-	}()
-	f()
+	var recovered any
+	wrapped := func() {
+		defer func() {
+			if r := recover(); r != nil {
+				recovered = r
+				panic(r)
+			}
+		}()
+		f()
+	}
+	bumert.For(t).Should(wrapped).Panic()
+	if expectedSubstring != "" {
+		bumert.For(t).Should(fmt.Sprintf("%v", recovered)).Contain(expectedSubstring)
+	}
 }
 
 // assertNotPanics asserts that the given function f does not panic.
 func assertNotPanics(t *testing.T, f func()) {
 	t.Helper()
-	defer func() {
-		if recovered := recover(); recovered != nil {
-			t.Errorf("Expected function not to panic, but it did: %v", recovered)
-		}
-	}()
-	f()
+	bumert.For(t).Should(f).NotPanic()
 }
 
 // --- Test Cases ---
@@ -224,6 +227,41 @@ func TestAssertion_BeEqual(t *testing.T) {
 	}
 }
 
+// TestAssertion_BeEqual_DiffOutput verifies that BeEqual's failure
+// message for multi-line operands (structs, slices, maps) includes a
+// unified diff with both "-" (expected) and "+" (got) lines, not just
+// the flat "should be equal" message.
+func TestAssertion_BeEqual_DiffOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{"Unequal structs", comparableStruct{1, "a"}, comparableStruct{2, "a"}},
+		{"Unequal slices", []int{1, 2}, []int{1, 3}},
+		{"Unequal maps", map[string]int{"a": 1}, map[string]int{"a": 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatal("expected BeEqual to panic")
+				}
+				msg := r.(string)
+				if !strings.Contains(msg, "- ") {
+					t.Errorf("expected a '-' diff line for the expected value, got: %q", msg)
+				}
+				if !strings.Contains(msg, "+ ") {
+					t.Errorf("expected a '+' diff line for the got value, got: %q", msg)
+				}
+			}()
+			bumert.Should(tt.value).BeEqual(tt.expected)
+		})
+	}
+}
+
 func TestAssertion_NotBeEqual(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -612,6 +650,12 @@ func TestAssertion_BeGreaterThan(t *testing.T) {
 		{"Uint > Uint", uint(10), uint(9), false, ""},
 		{"Int > Float (convertible)", 10, 9.5, false, ""},
 		{"Float > Int (convertible)", 10.5, 10, false, ""},
+		{"String > String", "banana", "apple", false, ""},
+		{"String == String", "apple", "apple", true, "should be greater than"},
+		{"String < String", "apple", "banana", true, "should be greater than"},
+		{"Time > Time", refTime.Add(time.Hour), refTime, false, ""},
+		{"Time == Time", refTime, refTime, true, "should be greater than"},
+		{"Time < Time", refTime, refTime.Add(time.Hour), true, "should be greater than"},
 		{"Incompatible types (string vs int)", "10", 9, true, "requires comparable numeric types"},
 		{"Incompatible types (struct vs int)", struct{}{}, 0, true, "requires comparable numeric types"},
 	}
@@ -645,6 +689,9 @@ func TestAssertion_BeLessThan(t *testing.T) {
 		{"Uint < Uint", uint(9), uint(10), false, ""},
 		{"Int < Float (convertible)", 9, 9.5, false, ""},
 		{"Float < Int (convertible)", 9.5, 10, false, ""},
+		{"String < String", "apple", "banana", false, ""},
+		{"String == String", "apple", "apple", true, "should be less than"},
+		{"Time < Time", refTime, refTime.Add(time.Hour), false, ""},
 		{"Incompatible types (string vs int)", "9", 10, true, "requires comparable numeric types"},
 	}
 
@@ -674,6 +721,10 @@ func TestAssertion_BeGreaterThanOrEqualTo(t *testing.T) {
 		{"Float > Float", 3.15, 3.14, false, ""},
 		{"Float == Float", 3.14, 3.14, false, ""},
 		{"Float < Float", 3.14, 3.15, true, "should be greater than or equal to"},
+		{"String >= String (greater)", "banana", "apple", false, ""},
+		{"String >= String (equal)", "apple", "apple", false, ""},
+		{"String >= String (less)", "apple", "banana", true, "should be greater than or equal to"},
+		{"Time >= Time (equal)", refTime, refTime, false, ""},
 	}
 
 	for _, tt := range tests {
@@ -702,6 +753,10 @@ func TestAssertion_BeLessThanOrEqualTo(t *testing.T) {
 		{"Float < Float", 3.14, 3.15, false, ""},
 		{"Float == Float", 3.14, 3.14, false, ""},
 		{"Float > Float", 3.15, 3.14, true, "should be less than or equal to"},
+		{"String <= String (less)", "apple", "banana", false, ""},
+		{"String <= String (equal)", "apple", "apple", false, ""},
+		{"String <= String (greater)", "banana", "apple", true, "should be less than or equal to"},
+		{"Time <= Time (equal)", refTime, refTime, false, ""},
 	}
 
 	for _, tt := range tests {
@@ -716,6 +771,87 @@ func TestAssertion_BeLessThanOrEqualTo(t *testing.T) {
 	}
 }
 
+// TestAssertion_Not verifies the fluent Not() inverter, that it only
+// applies to the next terminal check, and that And() is a transparent
+// chaining no-op.
+func TestAssertion_Not(t *testing.T) {
+	t.Run("Not().BeNil() matches NotBeNil()", func(t *testing.T) {
+		assertPanics(t, func() { bumert.Should(nil).Not().BeNil() }, "should not be nil")
+		assertNotPanics(t, func() { bumert.Should(42).Not().BeNil() })
+	})
+
+	t.Run("Not().BeZero() matches NotBeZero()", func(t *testing.T) {
+		assertPanics(t, func() { bumert.Should(0).Not().BeZero() }, "should not be the zero value")
+		assertNotPanics(t, func() { bumert.Should(1).Not().BeZero() })
+	})
+
+	t.Run("double negation cancels out", func(t *testing.T) {
+		assertNotPanics(t, func() { bumert.Should(nil).Not().Not().BeNil() })
+	})
+
+	t.Run("negation only applies to the next terminal check", func(t *testing.T) {
+		// Not() consumed by the first BeNil(); the second BeNil() call on
+		// the same (non-nil) chain is unaffected and panics normally.
+		assertPanics(t, func() {
+			a := bumert.Should(42).Not().BeNil()
+			a.BeNil()
+		}, "should be nil")
+	})
+
+	t.Run("And() chains without affecting negation", func(t *testing.T) {
+		assertNotPanics(t, func() { bumert.Should(1).NotBeZero().And().Not().BeZero() })
+	})
+
+	t.Run("Not() is honored by terminal checks beyond BeNil/BeZero", func(t *testing.T) {
+		var target *os.PathError
+		assertNotPanics(t, func() { bumert.Should(errors.New("boom")).Not().BeErrorOfType(&target) })
+		assertPanics(t, func() {
+			bumert.Should(&os.PathError{}).Not().BeErrorOfType(&target)
+		}, "error type should not be assignable")
+		assertPanics(t, func() { bumert.Should(10).Not().BeGreaterThan(5) }, "should not be greater than")
+	})
+
+	t.Run("an unconsumed negation never leaks into the next terminal check", func(t *testing.T) {
+		// Not() is consumed by BeGreaterThan itself (which now honors it),
+		// so the chain panics there instead of silently falling through to
+		// let the trailing BeZero() consume the stray negation.
+		assertPanics(t, func() { bumert.Should(10).Not().BeGreaterThan(5).BeZero() }, "should not be greater than")
+	})
+}
+
+func TestAssertion_BeBetween(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		lo, hi      any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"Int within range", 5, 1, 10, false, ""},
+		{"Int at lower bound", 1, 1, 10, false, ""},
+		{"Int at upper bound", 10, 1, 10, false, ""},
+		{"Int below range", 0, 1, 10, true, "should be between"},
+		{"Int above range", 11, 1, 10, true, "should be between"},
+		{"Float within range", 3.14, 3.0, 3.2, false, ""},
+		{"String within range", "banana", "apple", "cherry", false, ""},
+		{"String outside range", "date", "apple", "cherry", true, "should be between"},
+		{"Time within range", refTime.Add(30 * time.Minute), refTime, refTime.Add(time.Hour), false, ""},
+		{"Time outside range", refTime.Add(2 * time.Hour), refTime, refTime.Add(time.Hour), true, "should be between"},
+		{"Incompatible types", "5", 1, 10, true, "requires comparable numeric types"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeBetween(tt.lo, tt.hi) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
 func TestAssertion_BeError(t *testing.T) {
 	var nilErr error = nil
 	tests := []struct {
@@ -803,6 +939,139 @@ func TestAssertion_BeErrorOfType(t *testing.T) {
 	}
 }
 
+// posingError reports itself equal to any errorIsTarget error via Is,
+// regardless of identity, the way some sentinel-wrapping error types do.
+type posingError struct{}
+
+func (posingError) Error() string { return "posing error" }
+
+func (posingError) Is(target error) bool {
+	_, ok := target.(errorIsTarget)
+	return ok
+}
+
+type errorIsTarget struct{}
+
+func (errorIsTarget) Error() string { return "error is target" }
+
+// uncomparableError has a slice field, making instances uncomparable
+// with == and panicking if compared with it directly; errors.Is must
+// fall back to the Is(error) bool method instead.
+type uncomparableError struct {
+	tags []string
+}
+
+func (e uncomparableError) Error() string { return fmt.Sprintf("uncomparable: %v", e.tags) }
+
+func (e uncomparableError) Is(target error) bool {
+	_, ok := target.(uncomparableError)
+	return ok
+}
+
+func TestAssertion_BeErrorIs(t *testing.T) {
+	sentinel := errors.New("sentinel error")
+	other := errors.New("other error")
+
+	tests := []struct {
+		name        string
+		value       any
+		target      error
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"Exact match", sentinel, sentinel, false, ""},
+		{"Wrapped match", fmt.Errorf("wrapped: %w", sentinel), sentinel, false, ""},
+		{"Double-wrapped match", fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", sentinel)), sentinel, false, ""},
+		{"No match", other, sentinel, true, "error chain should match target"},
+		{"Nil error value", nil, sentinel, true, "should be a non-nil error"},
+		{"Non-error value", 123, sentinel, true, "should be a non-nil error"},
+		{"Poser matches via Is", posingError{}, errorIsTarget{}, false, ""},
+		{"Poser wrapped matches via Is", fmt.Errorf("wrapped: %w", posingError{}), errorIsTarget{}, false, ""},
+		{"Uncomparable error matches via Is", uncomparableError{tags: []string{"a"}}, uncomparableError{tags: []string{"b"}}, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeErrorIs(tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_NotBeErrorIs(t *testing.T) {
+	sentinel := errors.New("sentinel error")
+	other := errors.New("other error")
+
+	tests := []struct {
+		name        string
+		value       any
+		target      error
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"No match", other, sentinel, false, ""},
+		{"Nil error value", nil, sentinel, false, ""},
+		{"Non-error value", 123, sentinel, false, ""},
+		{"Exact match", sentinel, sentinel, true, "error chain should not match target"},
+		{"Wrapped match", fmt.Errorf("wrapped: %w", sentinel), sentinel, true, "error chain should not match target"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).NotBeErrorIs(tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeErrorAs(t *testing.T) {
+	var pathErr *os.PathError
+	var customErr *CustomError
+
+	tests := []struct {
+		name        string
+		value       any
+		target      any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"Correct type (os.PathError)", &os.PathError{Op: "read"}, &pathErr, false, ""},
+		{"Wrapped correct type", fmt.Errorf("wrapped: %w", &os.PathError{Op: "write"}), &pathErr, false, ""},
+		{"Correct custom type", &CustomError{Code: 1}, &customErr, false, ""},
+		{"Incorrect type", errors.New("generic error"), &pathErr, true, "error chain should contain type"},
+		{"Nil error value", nil, &pathErr, true, "should be a non-nil error"},
+		{"Non-error value", 123, &pathErr, true, "should be a non-nil error"},
+		{"Nil target (internal panic)", &os.PathError{}, nil, true, "target must be a non-nil pointer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeErrorAs(tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+
+	t.Run("target populated on success", func(t *testing.T) {
+		var pe *os.PathError
+		bumert.Should(&os.PathError{Op: "open", Path: "/tmp/x"}).BeErrorAs(&pe)
+		if pe == nil || pe.Op != "open" {
+			t.Fatalf("expected target to be populated with the matched error, got %+v", pe)
+		}
+	})
+}
+
 type CustomError struct {
 	Code int
 }
@@ -894,3 +1163,29 @@ func TestAssertf(t *testing.T) {
 		})
 	}
 }
+
+// TestCallerInfo verifies that failure messages are prefixed with the
+// file:line of the call site, not an internal bumert frame, for both the
+// package-level helpers and an *Assertion method.
+func TestCallerInfo(t *testing.T) {
+	t.Run("Assert", func(t *testing.T) {
+		_, _, wantLine, _ := runtime.Caller(0)
+		f := func() { bumert.Assert(false) }
+		wantLine++ // the call above is one line after runtime.Caller(0)
+		assertPanics(t, f, fmt.Sprintf("bumert_test.go:%d:", wantLine))
+	})
+
+	t.Run("Assertf", func(t *testing.T) {
+		_, _, wantLine, _ := runtime.Caller(0)
+		f := func() { bumert.Assertf(false, "boom") }
+		wantLine++
+		assertPanics(t, f, fmt.Sprintf("bumert_test.go:%d:", wantLine))
+	})
+
+	t.Run("Assertion method", func(t *testing.T) {
+		_, _, wantLine, _ := runtime.Caller(0)
+		f := func() { bumert.Should(false).BeTrue() }
+		wantLine++
+		assertPanics(t, f, fmt.Sprintf("bumert_test.go:%d:", wantLine))
+	})
+}
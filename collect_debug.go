@@ -0,0 +1,58 @@
+//go:build debug || bumert
+
+package bumert
+
+import "testing"
+
+// Collector collects assertion failures instead of panicking on the
+// first one, the same way a Group does, under the "collect, then report"
+// vocabulary: ctx := bumert.Collect(); defer ctx.Report(). It's backed by
+// a Group, so it's goroutine-safe for the same reason.
+type Collector struct {
+	group *Group
+	tb    testing.TB
+}
+
+// Collect starts an empty Collector.
+func Collect() *Collector {
+	return &Collector{group: NewGroup()}
+}
+
+// WithT binds the collector to tb: Report calls tb.Errorf once per
+// failure, each at its own recorded source location, instead of
+// panicking with all of them combined.
+func (c *Collector) WithT(tb testing.TB) *Collector {
+	c.tb = tb
+	return c
+}
+
+// Should starts an assertion chain bound to the collector: a failing
+// check is recorded instead of panicking, so later assertions still run.
+func (c *Collector) Should(value any) *Assertion {
+	return c.group.Should(value)
+}
+
+// Failed reports whether any assertion recorded so far has failed.
+func (c *Collector) Failed() bool {
+	return c.group.Failed()
+}
+
+// Report reports every failure collected so far. If the collector is
+// bound to a testing.TB via WithT, each failure is reported individually
+// through tb.Errorf; otherwise every failure is reported together through
+// the same panic/failure-handler path as Group.AssertAll. It is a no-op
+// if nothing failed.
+func (c *Collector) Report() {
+	if c.tb == nil {
+		c.group.AssertAll()
+		return
+	}
+	failures := c.group.snapshot()
+	if len(failures) == 0 {
+		return
+	}
+	c.tb.Helper()
+	for _, f := range failures {
+		c.tb.Errorf("%s: assertion failed: %s", f.callerInfo, f.message)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !debug && !bumert
+
+package bumert
+
+import "testing"
+
+// Collector is a no-op struct in release builds.
+type Collector struct{}
+
+// Collect is a no-op function in release builds.
+func Collect() *Collector {
+	return &Collector{}
+}
+
+// WithT is a no-op method in release builds.
+func (c *Collector) WithT(tb testing.TB) *Collector {
+	return c // Return receiver for chainability
+}
+
+// Should is a no-op method in release builds.
+func (c *Collector) Should(value any) *Assertion {
+	return &noOpAssertion
+}
+
+// Failed always reports false in release builds, since no check ever fails.
+func (c *Collector) Failed() bool {
+	return false
+}
+
+// Report is a no-op method in release builds.
+func (c *Collector) Report() {
+	// No-op
+}
@@ -0,0 +1,69 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestCollector_Report_CollectsFailures(t *testing.T) {
+	ctx := bumert.Collect()
+	ctx.Should(1).BeGreaterThan(10)
+	ctx.Should("foo").HaveLen(10)
+	ctx.Should(5).BeGreaterThan(0) // passes
+
+	if !ctx.Failed() {
+		t.Fatal("expected Failed() to report true after two failing checks")
+	}
+	assertPanics(t, ctx.Report, "2 assertion(s) failed")
+}
+
+func TestCollector_Report_NoFailures(t *testing.T) {
+	ctx := bumert.Collect()
+	ctx.Should(5).BeGreaterThan(0)
+
+	if ctx.Failed() {
+		t.Error("expected Failed() to report false when nothing failed")
+	}
+	assertNotPanics(t, ctx.Report)
+}
+
+// TestCollector_WithT verifies that a Collector bound to a testing.TB
+// reports each failure individually through Errorf instead of panicking.
+func TestCollector_WithT(t *testing.T) {
+	tb := &mockTB{}
+	ctx := bumert.Collect().WithT(tb)
+	ctx.Should(1).BeGreaterThan(10)
+	ctx.Should("foo").HaveLen(10)
+	ctx.Report()
+
+	if len(tb.fatalfCalls) != 0 {
+		t.Errorf("WithT should never call Fatalf, got: %v", tb.fatalfCalls)
+	}
+	if len(tb.errorfCalls) != 2 {
+		t.Fatalf("expected exactly two Errorf calls, got: %v", tb.errorfCalls)
+	}
+}
+
+// TestCollector_ConcurrentUse verifies the collector tolerates
+// concurrent Should calls without racing (run with -race to check).
+func TestCollector_ConcurrentUse(t *testing.T) {
+	ctx := bumert.Collect()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			ctx.Should(n).BeGreaterThan(100)
+		}(i)
+	}
+	wg.Wait()
+
+	if !ctx.Failed() {
+		t.Fatal("expected every check to have failed")
+	}
+	assertPanics(t, ctx.Report, "20 assertion(s) failed")
+}
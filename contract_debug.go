@@ -0,0 +1,83 @@
+//go:build debug || bumert
+
+package bumert
+
+// contractPhase distinguishes the two passes Ensures makes over its
+// callback: phaseCapture records "old" state at function entry without
+// checking anything; phaseCheck runs the real checks at exit.
+type contractPhase int
+
+const (
+	phaseCheck contractPhase = iota
+	phaseCapture
+)
+
+// Contract is the checker passed to Requires, Ensures, and Invariant
+// callbacks. Should starts an assertion chain the same way the
+// package-level Should does.
+type Contract struct {
+	phase    contractPhase
+	captured map[string]any
+}
+
+// Should starts an assertion chain against value. During an Ensures
+// callback's entry pass (see Ensures), checks are suppressed: only
+// Capture calls matter on that pass.
+func (c *Contract) Should(value any) *Assertion {
+	if c.phase == phaseCapture {
+		return NewGroup().Should(value) // recorded into a throwaway group, never reported
+	}
+	return Should(value)
+}
+
+// Capture records value under name so a later pass over the same
+// callback can read it back regardless of what's passed, the way
+// Eiffel's `old` keyword snapshots pre-call state. On Ensures' entry
+// pass, Capture stores value and returns it; on its exit pass, Capture
+// ignores the argument and returns what was stored at entry.
+func (c *Contract) Capture(name string, value any) any {
+	if c.phase == phaseCapture {
+		if c.captured == nil {
+			c.captured = map[string]any{}
+		}
+		c.captured[name] = value
+		return value
+	}
+	return c.captured[name]
+}
+
+// Requires checks preconditions immediately: fn receives a Contract whose
+// Should behaves exactly like the package-level Should, panicking (or
+// reporting via SetFailureHandler/UseTestingT, if one is installed) on
+// the first failure.
+func Requires(fn func(r *Contract)) {
+	fn(&Contract{phase: phaseCheck})
+}
+
+// Ensures checks postconditions at function exit. Call it via defer:
+//
+//	defer bumert.Ensures(func(e *bumert.Contract) {
+//	    old := e.Capture("len", len(slice))
+//	    e.Should(len(slice)).BeGreaterThan(old.(int))
+//	})()
+//
+// fn runs once immediately, with Should suppressed, so Capture calls
+// record "old" state at entry; Ensures returns a closure that re-runs fn
+// at exit (including on panic, if deferred), where Capture returns the
+// recorded state and Should checks for real.
+func Ensures(fn func(e *Contract)) func() {
+	c := &Contract{phase: phaseCapture}
+	fn(c)
+	return func() {
+		c.phase = phaseCheck
+		fn(c)
+	}
+}
+
+// Invariant re-checks a structural invariant against obj; call it at
+// multiple points in a function to confirm obj's invariant still holds.
+// obj itself isn't inspected by Invariant -- fn closes over it directly --
+// it documents what the invariant is about at each call site.
+func Invariant(obj any, fn func(i *Contract)) {
+	fn(&Contract{phase: phaseCheck})
+}
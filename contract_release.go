@@ -0,0 +1,33 @@
+//go:build !debug && !bumert
+
+package bumert
+
+// Contract is a no-op struct in release builds.
+type Contract struct{}
+
+// Should is a no-op method in release builds.
+func (c *Contract) Should(value any) *Assertion {
+	return &noOpAssertion
+}
+
+// Capture is a no-op method in release builds: it returns value
+// unchanged without recording anything.
+func (c *Contract) Capture(name string, value any) any {
+	return value
+}
+
+// Requires is a no-op function in release builds.
+func Requires(fn func(r *Contract)) {
+	// No-op
+}
+
+// Ensures is a no-op function in release builds: it returns a no-op
+// closure without invoking fn at all.
+func Ensures(fn func(e *Contract)) func() {
+	return func() {}
+}
+
+// Invariant is a no-op function in release builds.
+func Invariant(obj any, fn func(i *Contract)) {
+	// No-op
+}
@@ -0,0 +1,103 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestRequires_PassingPrecondition verifies Requires doesn't panic when
+// every check inside passes.
+func TestRequires_PassingPrecondition(t *testing.T) {
+	assertNotPanics(t, func() {
+		bumert.Requires(func(r *bumert.Contract) {
+			r.Should("localhost").NotBeEmpty()
+			r.Should(8080).BeGreaterThan(0)
+		})
+	})
+}
+
+// TestRequires_FailingPrecondition verifies Requires panics immediately,
+// the same way Should does, when a check inside fails.
+func TestRequires_FailingPrecondition(t *testing.T) {
+	assertPanics(t, func() {
+		bumert.Requires(func(r *bumert.Contract) {
+			r.Should("").NotBeEmpty()
+		})
+	}, "should not be empty")
+}
+
+// TestEnsures_PassingPostcondition verifies the closure returned by
+// Ensures doesn't panic when the postcondition holds at exit.
+func TestEnsures_PassingPostcondition(t *testing.T) {
+	cfg := "configured"
+	assertNotPanics(t, func() {
+		done := bumert.Ensures(func(e *bumert.Contract) {
+			e.Should(cfg).NotBeNil()
+		})
+		done()
+	})
+}
+
+// TestEnsures_FailingPostcondition verifies the postcondition isn't
+// checked for real until the closure Ensures returns is invoked, and
+// that it panics at that point when the postcondition fails.
+func TestEnsures_FailingPostcondition(t *testing.T) {
+	var done func()
+	assertNotPanics(t, func() {
+		done = bumert.Ensures(func(e *bumert.Contract) {
+			e.Should(1).BeGreaterThan(10) // would fail, but not checked on this pass
+		})
+	})
+	assertPanics(t, done, "should be greater than")
+}
+
+// TestEnsures_CapturesOldValue verifies Capture records a value on
+// Ensures' first pass and plays it back unchanged on the deferred pass,
+// regardless of what's passed the second time.
+func TestEnsures_CapturesOldValue(t *testing.T) {
+	slice := []int{1, 2, 3}
+	var old int
+	done := bumert.Ensures(func(e *bumert.Contract) {
+		old = e.Capture("len", len(slice)).(int)
+		e.Should(len(slice)).BeGreaterThan(old)
+	})
+	if old != 3 {
+		t.Fatalf("expected Capture to record 3 at entry, got %d", old)
+	}
+
+	slice = append(slice, 4)
+	assertNotPanics(t, done)
+}
+
+// TestEnsures_EntryPassSuppressesChecks verifies that Should calls made
+// during Ensures' entry pass never panic, even if they'd otherwise fail --
+// only the deferred pass's checks count.
+func TestEnsures_EntryPassSuppressesChecks(t *testing.T) {
+	assertNotPanics(t, func() {
+		done := bumert.Ensures(func(e *bumert.Contract) {
+			e.Should(1).BeGreaterThan(10) // would fail, but must not panic here
+		})
+		assertPanics(t, done, "should be greater than")
+	})
+}
+
+// TestInvariant_PassingAndFailing verifies Invariant checks immediately,
+// panicking only when the invariant is violated.
+func TestInvariant_PassingAndFailing(t *testing.T) {
+	balance := 100
+	assertNotPanics(t, func() {
+		bumert.Invariant(balance, func(i *bumert.Contract) {
+			i.Should(balance).BeGreaterThanOrEqualTo(0)
+		})
+	})
+
+	balance = -5
+	assertPanics(t, func() {
+		bumert.Invariant(balance, func(i *bumert.Contract) {
+			i.Should(balance).BeGreaterThanOrEqualTo(0)
+		})
+	}, "should be greater than or equal to")
+}
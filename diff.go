@@ -0,0 +1,375 @@
+package bumert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// DiffConfig controls how bumert renders the expected/got values in a
+// failed equality-style assertion (BeEqual, NotBeEqual, HaveLen,
+// Contain). It is a pure formatting knob: it never changes whether an
+// assertion passes, only how its failure is reported.
+type DiffConfig struct {
+	// MaxDepth bounds how deeply nested structs/maps/slices are walked
+	// before the dumper elides the remainder with "...".
+	MaxDepth int
+	// MaxStringLength truncates long strings to keep dumps readable. Zero
+	// or negative disables truncation.
+	MaxStringLength int
+	// SortMapKeys renders map entries in a stable, sorted order so dumps
+	// (and therefore diffs) are deterministic across runs.
+	SortMapKeys bool
+	// DisablePointerAddrs omits the "0xc0001234" address prefix on
+	// pointers, which otherwise differs on every run and is rarely useful
+	// in a failure message.
+	DisablePointerAddrs bool
+	// Differ, when set, replaces the default dump-and-unified-diff
+	// rendering entirely: BeEqual calls Differ(got, want) and appends the
+	// returned string as-is, the way Should(x).WithDiff(cmp.Diff) lets
+	// callers plug in e.g. google/go-cmp.
+	Differ func(got, want any) string
+	// Redactors mask struct field and map entry values before they're
+	// dumped, keyed by a dotted path like "User.Password" or
+	// "Config[token]" (map keys are formatted with %v, unquoted). Each redactor is tried in order for a given
+	// path; the first that returns a non-nil replacement wins, and the
+	// replacement (not the original value) is what gets dumped.
+	Redactors []func(path string, value any) any
+}
+
+// DefaultDiffConfig is the DiffConfig used by BeEqual, NotBeEqual,
+// HaveLen, and Contain when rendering failure messages. It is bumert's
+// global diff configuration: mutate it directly (e.g. to register
+// Redactors or lower MaxDepth) to change every assertion's failure
+// output, or override it for one chain with (*Assertion).WithDiff.
+var DefaultDiffConfig = DiffConfig{
+	MaxDepth:            10,
+	MaxStringLength:     120,
+	SortMapKeys:         true,
+	DisablePointerAddrs: true,
+}
+
+// SetDiffRenderer installs renderer as DefaultDiffConfig.Differ, so every
+// failure that appends a diff (BeEqual, and likewise BeJSONEqual,
+// HaveKeyWithValue and PanicWithValue) across the whole package renders
+// it through renderer instead of bumert's built-in dump-and-unified-diff
+// output -- handy for plugging in e.g. google/go-cmp's cmp.Diff without
+// forking. Failures that only print dumps (NotBeEqual, HaveLen, Contain)
+// are unaffected. Passing nil restores the built-in renderer. For
+// overriding just one assertion chain instead of the package-wide
+// default, use (*Assertion).WithDiff.
+func SetDiffRenderer(renderer func(expected, actual any) string) {
+	if renderer == nil {
+		DefaultDiffConfig.Differ = nil
+		return
+	}
+	// Differ takes (got, want); swap so renderer sees (expected, actual).
+	DefaultDiffConfig.Differ = func(got, want any) string { return renderer(want, got) }
+}
+
+// allowedUnexportedTypes holds the types registered via AllowUnexported.
+// dump() only reaches into a struct's unexported fields when its type is
+// present here; otherwise they're rendered as "<unexported>".
+var allowedUnexportedTypes = map[reflect.Type]bool{}
+
+// AllowUnexported registers the concrete types of sample (zero values are
+// fine, e.g. AllowUnexported(MyStruct{})) so dump() can reach their
+// unexported fields. Unexported fields of types that were never
+// registered are rendered as "<unexported>" instead, mirroring
+// go-cmp's cmpopts.AllowUnexported but applied globally to bumert's
+// dumper rather than per comparison.
+func AllowUnexported(samples ...any) {
+	for _, s := range samples {
+		allowedUnexportedTypes[reflect.TypeOf(s)] = true
+	}
+}
+
+// applyRedactors returns the first non-nil replacement a redactor in cfg
+// produces for (path, value), or value unchanged if none apply.
+func applyRedactors(cfg DiffConfig, path string, value any) any {
+	for _, redact := range cfg.Redactors {
+		if replaced := redact(path, value); replaced != nil {
+			return replaced
+		}
+	}
+	return value
+}
+
+// dump renders v as an indented, human-readable tree, the way a debugger
+// or go-spew would: struct field names, sorted map keys, cycle-safe
+// pointer traversal, and (when its type was registered via
+// AllowUnexported) unexported fields reached via unsafe, the same trick
+// reflect-heavy dumpers like go-spew use.
+func dump(v any, cfg DiffConfig) string {
+	var sb strings.Builder
+	rv := reflect.ValueOf(v)
+	if rv.IsValid() {
+		// Copy into an addressable value so unexported struct fields
+		// nested below can be reached via UnsafeAddr.
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+	dumpValue(&sb, rv, cfg, 0, map[uintptr]bool{}, "")
+	return sb.String()
+}
+
+func dumpValue(sb *strings.Builder, v reflect.Value, cfg DiffConfig, depth int, seen map[uintptr]bool, path string) {
+	if !v.IsValid() {
+		sb.WriteString("nil")
+		return
+	}
+	if cfg.MaxDepth > 0 && depth > cfg.MaxDepth {
+		sb.WriteString("...")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		addr := v.Pointer()
+		if seen[addr] {
+			sb.WriteString("<cyclic>")
+			return
+		}
+		seen[addr] = true
+		defer delete(seen, addr)
+		if !cfg.DisablePointerAddrs {
+			fmt.Fprintf(sb, "0x%x ", addr)
+		}
+		sb.WriteByte('&')
+		dumpValue(sb, v.Elem(), cfg, depth, seen, path)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		dumpValue(sb, v.Elem(), cfg, depth, seen, path)
+
+	case reflect.Struct:
+		fmt.Fprintf(sb, "%s{\n", v.Type())
+		allowUnexported := allowedUnexportedTypes[v.Type()]
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			fv := v.Field(i)
+			fieldPath := joinPath(path, field.Name)
+			fmt.Fprintf(sb, "%s%s: ", strings.Repeat("  ", depth+1), field.Name)
+
+			if !fv.CanInterface() {
+				if !allowUnexported {
+					sb.WriteString("<unexported>,\n")
+					continue
+				}
+				if fv.CanAddr() {
+					fv = reflect.NewAt(fv.Type(), unsafe.Pointer(fv.UnsafeAddr())).Elem()
+				}
+			}
+			if len(cfg.Redactors) > 0 && fv.CanInterface() {
+				redacted := applyRedactors(cfg, fieldPath, fv.Interface())
+				fv = reflect.ValueOf(redacted)
+			}
+			dumpValue(sb, fv, cfg, depth+1, seen, fieldPath)
+			sb.WriteString(",\n")
+		}
+		fmt.Fprintf(sb, "%s}", strings.Repeat("  ", depth))
+
+	case reflect.Map:
+		fmt.Fprintf(sb, "%s{\n", v.Type())
+		keys := v.MapKeys()
+		if cfg.SortMapKeys {
+			sort.Slice(keys, func(i, j int) bool {
+				return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+			})
+		}
+		for _, k := range keys {
+			entryPath := fmt.Sprintf("%s[%v]", path, k.Interface())
+			mv := v.MapIndex(k)
+			if len(cfg.Redactors) > 0 && mv.CanInterface() {
+				redacted := applyRedactors(cfg, entryPath, mv.Interface())
+				mv = reflect.ValueOf(redacted)
+			}
+			fmt.Fprintf(sb, "%s%v: ", strings.Repeat("  ", depth+1), k.Interface())
+			dumpValue(sb, mv, cfg, depth+1, seen, entryPath)
+			sb.WriteString(",\n")
+		}
+		fmt.Fprintf(sb, "%s}", strings.Repeat("  ", depth))
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		fmt.Fprintf(sb, "%s{\n", v.Type())
+		for i := 0; i < v.Len(); i++ {
+			sb.WriteString(strings.Repeat("  ", depth+1))
+			dumpValue(sb, v.Index(i), cfg, depth+1, seen, fmt.Sprintf("%s[%d]", path, i))
+			sb.WriteString(",\n")
+		}
+		fmt.Fprintf(sb, "%s}", strings.Repeat("  ", depth))
+
+	case reflect.String:
+		s := v.String()
+		if cfg.MaxStringLength > 0 && len(s) > cfg.MaxStringLength {
+			s = fmt.Sprintf("%s...(%d more)", s[:cfg.MaxStringLength], len(s)-cfg.MaxStringLength)
+		}
+		fmt.Fprintf(sb, "%q", s)
+
+	default:
+		if v.CanInterface() {
+			fmt.Fprintf(sb, "%#v", v.Interface())
+		} else {
+			fmt.Fprintf(sb, "%v", v)
+		}
+	}
+}
+
+// joinPath appends field to a dotted redaction path, e.g.
+// joinPath("User", "Password") -> "User.Password".
+func joinPath(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
+
+// diffOp is one line of a Myers-style line diff: unchanged (' '), only in
+// expected ('-'), or only in got ('+').
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// myersDiff computes the minimal edit script between a and b using the
+// classic O(n*m) longest-common-subsequence table; equivalent in result
+// to the greedy Myers algorithm for the modestly sized dumps bumert deals
+// with.
+func myersDiff(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// renderUnifiedDiff renders ops as hunks of changed lines surrounded by
+// up to context lines of unchanged context, eliding the rest with "...".
+func renderUnifiedDiff(ops []diffOp, context int) string {
+	n := len(ops)
+	type hunk struct{ start, end int } // [start, end)
+	var hunks []hunk
+	for i := 0; i < n; {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := i
+		for i < n && ops[i].kind != ' ' {
+			i++
+		}
+		hunks = append(hunks, hunk{start, i})
+	}
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var merged []hunk
+	for _, h := range hunks {
+		s, e := h.start-context, h.end+context
+		if s < 0 {
+			s = 0
+		}
+		if e > n {
+			e = n
+		}
+		if len(merged) > 0 && s <= merged[len(merged)-1].end {
+			merged[len(merged)-1].end = e
+		} else {
+			merged = append(merged, hunk{s, e})
+		}
+	}
+
+	var sb strings.Builder
+	for hi, h := range merged {
+		if hi > 0 {
+			sb.WriteString("  ...\n")
+		}
+		for k := h.start; k < h.end; k++ {
+			switch ops[k].kind {
+			case ' ':
+				fmt.Fprintf(&sb, "  %s\n", ops[k].line)
+			case '-':
+				fmt.Fprintf(&sb, "- %s\n", ops[k].line)
+			case '+':
+				fmt.Fprintf(&sb, "+ %s\n", ops[k].line)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// diffValues dumps expected and actual with cfg and, if the dumps span
+// more than one line, returns a unified diff between them (3 lines of
+// context per hunk). If cfg.Differ is set, it's used instead of the
+// default dump-and-diff rendering. Scalar values that dump to a single
+// line return an empty string; callers fall back to printing both dumps
+// inline.
+func diffValues(expected, actual any, cfg DiffConfig) string {
+	if cfg.Differ != nil {
+		if custom := cfg.Differ(actual, expected); custom != "" {
+			return "diff:\n" + custom
+		}
+		return ""
+	}
+	expDump := dump(expected, cfg)
+	gotDump := dump(actual, cfg)
+	if !strings.Contains(expDump, "\n") && !strings.Contains(gotDump, "\n") {
+		return ""
+	}
+	ops := myersDiff(strings.Split(expDump, "\n"), strings.Split(gotDump, "\n"))
+	body := renderUnifiedDiff(ops, 3)
+	if body == "" {
+		return ""
+	}
+	return "diff (- expected, + got):\n" + body
+}
@@ -0,0 +1,152 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestAssertion_WithDiff verifies that WithDiff plugs a custom differ
+// into BeEqual's failure message for that one chain only.
+func TestAssertion_WithDiff(t *testing.T) {
+	var called bool
+	differ := func(got, want any) string {
+		called = true
+		return "custom diff output"
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		if !called {
+			t.Error("expected the custom differ to be invoked")
+		}
+		msg := r.(string)
+		if !strings.Contains(msg, "custom diff output") {
+			t.Errorf("expected panic message to include the custom differ's output, got: %q", msg)
+		}
+	}()
+	bumert.Should(1).WithDiff(differ).BeEqual(2)
+}
+
+// TestAssertion_WithDiff_DoesNotAffectOtherChains verifies WithDiff is
+// scoped to the chain it's called on.
+func TestAssertion_WithDiff_DoesNotAffectOtherChains(t *testing.T) {
+	assertPanics(t, func() {
+		bumert.Should(1).WithDiff(func(got, want any) string { return "custom diff output" }).BeEqual(2)
+	}, "custom diff output")
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		msg := r.(string)
+		if strings.Contains(msg, "custom diff output") {
+			t.Errorf("a later chain without WithDiff should not see the earlier chain's differ, got: %q", msg)
+		}
+	}()
+	bumert.Should(3).BeEqual(4)
+}
+
+// TestSetDiffRenderer verifies SetDiffRenderer installs a package-wide
+// differ used by every chain that doesn't override it with WithDiff, and
+// that it can be restored to the built-in renderer by passing nil.
+func TestSetDiffRenderer(t *testing.T) {
+	defer bumert.SetDiffRenderer(nil)
+
+	var called bool
+	bumert.SetDiffRenderer(func(expected, actual any) string {
+		called = true
+		return "package-wide custom diff"
+	})
+
+	assertPanics(t, func() {
+		bumert.Should(1).BeEqual(2)
+	}, "package-wide custom diff")
+	if !called {
+		t.Error("expected the installed renderer to be invoked")
+	}
+
+	bumert.SetDiffRenderer(nil)
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		msg := r.(string)
+		if strings.Contains(msg, "package-wide custom diff") {
+			t.Errorf("expected the built-in renderer to be restored, got: %q", msg)
+		}
+	}()
+	bumert.Should(3).BeEqual(4)
+}
+
+type secretConfig struct {
+	Name     string
+	Password string
+}
+
+// TestDefaultDiffConfig_Redactors verifies that a registered redactor
+// masks a field's value in BeEqual's rendered dump.
+func TestDefaultDiffConfig_Redactors(t *testing.T) {
+	original := bumert.DefaultDiffConfig.Redactors
+	bumert.DefaultDiffConfig.Redactors = []func(path string, value any) any{
+		func(path string, value any) any {
+			if path == "Password" {
+				return "<redacted>"
+			}
+			return nil
+		},
+	}
+	defer func() { bumert.DefaultDiffConfig.Redactors = original }()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		msg := r.(string)
+		if strings.Contains(msg, "hunter2") {
+			t.Errorf("expected the real password to be redacted, got: %q", msg)
+		}
+		if !strings.Contains(msg, "<redacted>") {
+			t.Errorf("expected the redacted placeholder to appear, got: %q", msg)
+		}
+	}()
+	bumert.Should(secretConfig{Name: "a", Password: "hunter2"}).
+		BeEqual(secretConfig{Name: "b", Password: "hunter2"})
+}
+
+type withUnexported struct {
+	Public  string
+	private string
+}
+
+// TestAllowUnexported verifies that unexported fields are hidden by
+// default and reachable once their type is registered.
+func TestAllowUnexported(t *testing.T) {
+	assertPanics(t, func() {
+		bumert.Should(withUnexported{Public: "a", private: "x"}).BeEqual(withUnexported{Public: "b", private: "x"})
+	}, "<unexported>")
+
+	bumert.AllowUnexported(withUnexported{})
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		msg := r.(string)
+		if !strings.Contains(msg, `"secret"`) {
+			t.Errorf("expected the unexported field's value to be visible once allowed, got: %q", msg)
+		}
+	}()
+	bumert.Should(withUnexported{Public: "a", private: "secret"}).
+		BeEqual(withUnexported{Public: "a", private: "other"})
+}
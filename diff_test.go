@@ -0,0 +1,53 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestAssertion_BeEqual_DiffContent checks that a failing BeEqual on
+// composite values renders a readable dump plus a unified diff, instead
+// of the old flat %#v dump.
+func TestAssertion_BeEqual_DiffContent(t *testing.T) {
+	type point struct{ X, Y int }
+	expected := point{X: 1, Y: 2}
+	got := point{X: 1, Y: 3}
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected BeEqual to panic")
+		}
+		msg := r.(string)
+		if !strings.Contains(msg, "should be equal") {
+			t.Errorf("expected message to contain 'should be equal', got: %q", msg)
+		}
+		if !strings.Contains(msg, "diff (- expected, + got)") {
+			t.Errorf("expected message to contain a unified diff header, got: %q", msg)
+		}
+		if !strings.Contains(msg, "-   Y: 2,") || !strings.Contains(msg, "+   Y: 3,") {
+			t.Errorf("expected diff to show the changed Y field, got: %q", msg)
+		}
+	}()
+	bumert.Should(got).BeEqual(expected)
+}
+
+// TestAssertion_HaveLen_Dump checks that HaveLen renders collections via
+// the dumper rather than a flat %#v blob.
+func TestAssertion_HaveLen_Dump(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected HaveLen to panic")
+		}
+		msg := r.(string)
+		if !strings.Contains(msg, "[]string{") {
+			t.Errorf("expected dumped collection type header, got: %q", msg)
+		}
+	}()
+	bumert.Should([]string{"a", "b"}).HaveLen(3)
+}
@@ -0,0 +1,15 @@
+package bumert
+
+import "fmt"
+
+// WrapError wraps err with message using fmt.Errorf's %w verb, so the
+// result participates in errors.Is/errors.As chains the same way
+// BeErrorIs/BeErrorAs and the stdlib errors package expect. It returns
+// nil if err is nil, the way most wrapping helpers do so callers can
+// write `return bumert.WrapError(err, "context")` unconditionally.
+func WrapError(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
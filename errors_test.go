@@ -0,0 +1,29 @@
+package bumert_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestWrapError(t *testing.T) {
+	inner := errors.New("disk full")
+
+	wrapped := bumert.WrapError(inner, "writing file")
+	if wrapped == nil {
+		t.Fatal("expected a non-nil wrapped error")
+	}
+	if !errors.Is(wrapped, inner) {
+		t.Errorf("expected errors.Is(wrapped, inner) to hold, got: %v", wrapped)
+	}
+	if got, want := wrapped.Error(), "writing file: disk full"; got != want {
+		t.Errorf("got message %q, want %q", got, want)
+	}
+}
+
+func TestWrapError_NilError(t *testing.T) {
+	if got := bumert.WrapError(nil, "writing file"); got != nil {
+		t.Errorf("expected WrapError(nil, ...) to return nil, got: %v", got)
+	}
+}
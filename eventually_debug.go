@@ -0,0 +1,102 @@
+//go:build debug || bumert
+
+package bumert
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Eventually polls cond every interval until it returns true or timeout
+// elapses. Panics (or routes through SetFailureHandler/UseTestingT, if
+// one is installed) with caller info if cond never becomes true in time.
+func Eventually(cond func() bool, timeout, interval time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for {
+		if cond() {
+			return
+		}
+		if time.Now().After(deadline) {
+			failAssertion("condition did not become true within %v", timeout) // Skips failAssertion and Eventually
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Never polls cond every interval for duration and fails as soon as cond
+// returns true. It succeeds if cond stays false for the whole window.
+func Never(cond func() bool, duration, interval time.Duration) {
+	deadline := time.Now().Add(duration)
+	for time.Now().Before(deadline) {
+		if cond() {
+			failAssertion("condition became true within %v, expected it never to", duration) // Skips failAssertion and Never
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// EventuallyCtx is like Eventually, but polls cond every interval until
+// it returns true or ctx is done, whichever comes first.
+func EventuallyCtx(ctx context.Context, cond func() bool, interval time.Duration) {
+	for {
+		if cond() {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			failAssertion("condition did not become true before context was done: %v", ctx.Err()) // Skips failAssertion and EventuallyCtx
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// EventuallyReturnTrue polls the asserted func() bool every interval
+// until it returns true or timeout elapses.
+// Panics if the value isn't a func() bool or the timeout is reached.
+// Does not honor Not(): a pending Not() is cleared without inverting
+// this check.
+func (a *Assertion) EventuallyReturnTrue(timeout, interval time.Duration) *Assertion {
+	a.consumeNegation()
+	fn, ok := a.value.(func() bool)
+	if !ok {
+		a.fail("EventuallyReturnTrue requires a func() bool value, got %T", a.value)
+		return a
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		if fn() {
+			return a
+		}
+		if time.Now().After(deadline) {
+			a.fail("condition did not become true within %v", timeout)
+			return a
+		}
+		time.Sleep(interval)
+	}
+}
+
+// EventuallyReceive waits for a value to arrive on the asserted channel
+// within timeout.
+// Panics if the value isn't a receivable channel or nothing arrives in
+// time. Does not honor Not(): a pending Not() is cleared without
+// inverting this check.
+func (a *Assertion) EventuallyReceive(timeout time.Duration) *Assertion {
+	a.consumeNegation()
+	v := reflect.ValueOf(a.value)
+	if !v.IsValid() || v.Kind() != reflect.Chan || v.Type().ChanDir() == reflect.SendDir {
+		a.fail("EventuallyReceive requires a receivable channel, got %T", a.value)
+		return a
+	}
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: v},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(time.After(timeout))},
+	}
+	if chosen, _, _ := reflect.Select(cases); chosen == 1 {
+		a.fail("did not receive from channel within %v", timeout)
+	}
+	return a
+}
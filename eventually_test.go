@@ -0,0 +1,113 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestEventually(t *testing.T) {
+	t.Run("becomes true before timeout", func(t *testing.T) {
+		count := 0
+		assertNotPanics(t, func() {
+			bumert.Eventually(func() bool {
+				count++
+				return count >= 3
+			}, 200*time.Millisecond, time.Millisecond)
+		})
+	})
+
+	t.Run("never becomes true", func(t *testing.T) {
+		assertPanics(t, func() {
+			bumert.Eventually(func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+		}, "condition did not become true")
+	})
+}
+
+func TestNever(t *testing.T) {
+	t.Run("stays false", func(t *testing.T) {
+		assertNotPanics(t, func() {
+			bumert.Never(func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+		})
+	})
+
+	t.Run("becomes true", func(t *testing.T) {
+		count := 0
+		assertPanics(t, func() {
+			bumert.Never(func() bool {
+				count++
+				return count >= 3
+			}, 200*time.Millisecond, time.Millisecond)
+		}, "expected it never to")
+	})
+}
+
+func TestEventuallyCtx(t *testing.T) {
+	t.Run("becomes true before ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		count := 0
+		assertNotPanics(t, func() {
+			bumert.EventuallyCtx(ctx, func() bool {
+				count++
+				return count >= 3
+			}, time.Millisecond)
+		})
+	})
+
+	t.Run("ctx done before condition", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		assertPanics(t, func() {
+			bumert.EventuallyCtx(ctx, func() bool { return false }, time.Millisecond)
+		}, "condition did not become true before context was done")
+	})
+}
+
+func TestAssertion_EventuallyReturnTrue(t *testing.T) {
+	t.Run("becomes true", func(t *testing.T) {
+		count := 0
+		assertNotPanics(t, func() {
+			bumert.Should(func() bool {
+				count++
+				return count >= 3
+			}).EventuallyReturnTrue(200*time.Millisecond, time.Millisecond)
+		})
+	})
+
+	t.Run("wrong value type", func(t *testing.T) {
+		assertPanics(t, func() {
+			bumert.Should(42).EventuallyReturnTrue(10*time.Millisecond, time.Millisecond)
+		}, "requires a func() bool value")
+	})
+}
+
+func TestAssertion_EventuallyReceive(t *testing.T) {
+	t.Run("receives in time", func(t *testing.T) {
+		ch := make(chan int, 1)
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			ch <- 1
+		}()
+		assertNotPanics(t, func() {
+			bumert.Should(ch).EventuallyReceive(200 * time.Millisecond)
+		})
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		ch := make(chan int)
+		assertPanics(t, func() {
+			bumert.Should(ch).EventuallyReceive(20 * time.Millisecond)
+		}, "did not receive from channel")
+	})
+
+	t.Run("not a channel", func(t *testing.T) {
+		assertPanics(t, func() {
+			bumert.Should(42).EventuallyReceive(10 * time.Millisecond)
+		}, "requires a receivable channel")
+	})
+}
@@ -0,0 +1,582 @@
+//go:build debug || bumert
+
+package bumert
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// BeInDelta checks that the asserted numeric value is within delta of
+// expected, i.e. math.Abs(value-expected) <= delta. Equal infinities
+// count as within delta; a NaN value never does.
+// Panics if the value isn't numeric or falls outside the delta. Honors
+// Not(): Should(x).Not().BeInDelta(e, d) fails if x falls within delta.
+func (a *Assertion) BeInDelta(expected float64, delta float64) *Assertion {
+	got, ok := convertToFloat64(reflect.ValueOf(a.value))
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeInDelta requires a numeric value, got %T", a.value)
+		return a
+	}
+	diff := math.Abs(got - expected)
+	pass := diff <= delta || got == expected
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be within delta %v of %v, but got %v (diff %v)", delta, expected, got, diff)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be within delta %v of %v, but got %v (diff %v)", delta, expected, got, diff)
+	}
+	return a
+}
+
+// BeInEpsilon checks that the asserted numeric value is within a
+// relative epsilon of expected, i.e.
+// math.Abs((value-expected)/expected) <= epsilon. Equal infinities count
+// as within epsilon; a NaN value never does.
+// Panics if the value isn't numeric, if expected is zero (the relative
+// comparison is undefined), or if the epsilon is exceeded. Honors Not():
+// Should(x).Not().BeInEpsilon(e, eps) fails if x falls within epsilon.
+func (a *Assertion) BeInEpsilon(expected float64, epsilon float64) *Assertion {
+	got, ok := convertToFloat64(reflect.ValueOf(a.value))
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeInEpsilon requires a numeric value, got %T", a.value)
+		return a
+	}
+	if expected == 0 {
+		a.consumeNegation()
+		a.fail("epsilon comparison undefined when expected is zero")
+		return a
+	}
+	diff := math.Abs((got - expected) / expected)
+	pass := diff <= epsilon || got == expected
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be within epsilon %v of %v, but got %v (relative diff %v)", epsilon, expected, got, diff)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be within epsilon %v of %v, but got %v (relative diff %v)", epsilon, expected, got, diff)
+	}
+	return a
+}
+
+// BeWithinDelta checks that the asserted numeric value is within delta of
+// expected, i.e. math.Abs(value-expected) <= delta, the same comparison
+// BeInDelta makes but accepting expected as any real numeric type
+// instead of requiring a float64. Two infinities of the same sign count
+// as within delta.
+// Panics if either side isn't a comparable numeric type, if either side
+// is math.NaN(), or if the values fall outside delta. Honors Not():
+// Should(x).Not().BeWithinDelta(e, d) fails if x falls within delta.
+func (a *Assertion) BeWithinDelta(expected any, delta float64) *Assertion {
+	got, expFloat, ok := bothToFloat64(a.value, expected)
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeWithinDelta requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if math.IsNaN(got) || math.IsNaN(expFloat) {
+		a.consumeNegation()
+		a.fail("NaN cannot be compared")
+		return a
+	}
+	diff := math.Abs(got - expFloat)
+	pass := !(diff > delta)
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be within delta %v of %v, but got %v (diff %v)", delta, expFloat, got, diff)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be within delta %v of %v, but got %v (diff %v)", delta, expFloat, got, diff)
+	}
+	return a
+}
+
+// BeWithinEpsilon checks that the asserted numeric value is within a
+// relative epsilon of expected, i.e.
+// math.Abs((value-expected)/expected) <= epsilon, the same comparison
+// BeInEpsilon makes but accepting expected as any real numeric type
+// instead of requiring a float64. Two infinities of the same sign count
+// as within epsilon; a finite value is never within epsilon of an
+// infinity.
+// Panics if either side isn't a comparable numeric type, if either side
+// is math.NaN(), if expected is zero (the relative comparison is
+// undefined), or if the epsilon is exceeded. Honors Not():
+// Should(x).Not().BeWithinEpsilon(e, eps) fails if x falls within epsilon.
+func (a *Assertion) BeWithinEpsilon(expected any, epsilon float64) *Assertion {
+	got, expFloat, ok := bothToFloat64(a.value, expected)
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeWithinEpsilon requires comparable numeric types, got %T and %T", a.value, expected)
+		return a
+	}
+	if math.IsNaN(got) || math.IsNaN(expFloat) {
+		a.consumeNegation()
+		a.fail("NaN cannot be compared")
+		return a
+	}
+	if expFloat == 0 {
+		a.consumeNegation()
+		a.fail("epsilon comparison undefined when expected is zero")
+		return a
+	}
+	diff := math.Abs((got - expFloat) / expFloat)
+	pass := diff <= epsilon || got == expFloat
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be within epsilon %v of %v, but got %v (relative diff %v)", epsilon, expFloat, got, diff)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be within epsilon %v of %v, but got %v (relative diff %v)", epsilon, expFloat, got, diff)
+	}
+	return a
+}
+
+// bothToFloat64 converts both value and expected to float64 via
+// convertToFloat64, succeeding only if both sides convert.
+func bothToFloat64(value, expected any) (valFloat, expFloat float64, ok bool) {
+	valFloat, okV := convertToFloat64(reflect.ValueOf(value))
+	expFloat, okE := convertToFloat64(reflect.ValueOf(expected))
+	return valFloat, expFloat, okV && okE
+}
+
+// matchSubject converts value to the string MatchRegexp/NotMatchRegexp
+// should match against, accepting string, []byte, and fmt.Stringer.
+func matchSubject(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case fmt.Stringer:
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
+
+// compileRegexpPattern resolves pattern to a *regexp.Regexp, accepting
+// either a string (compiled lazily) or an already-compiled
+// *regexp.Regexp. Panics if pattern is neither, or if a string pattern
+// fails to compile.
+func compileRegexpPattern(pattern any) *regexp.Regexp {
+	switch p := pattern.(type) {
+	case *regexp.Regexp:
+		return p
+	case string:
+		re, err := regexp.Compile(p)
+		if err != nil {
+			panic(fmt.Sprintf("invalid regexp pattern %q: %v", p, err))
+		}
+		return re
+	default:
+		panic(fmt.Sprintf("internal bumert error: MatchRegexp/NotMatchRegexp pattern must be a string or *regexp.Regexp, got %T", pattern))
+	}
+}
+
+// MatchRegexp checks that the asserted value matches pattern. The
+// asserted value must be a string, []byte, or fmt.Stringer; pattern may
+// be a string (compiled lazily) or a pre-compiled *regexp.Regexp.
+// Panics if the value isn't a matchable subject, pattern fails to
+// compile, or the subject doesn't match. Honors Not():
+// Should(x).Not().MatchRegexp(p) is equivalent to NotMatchRegexp(p).
+func (a *Assertion) MatchRegexp(pattern any) *Assertion {
+	str, ok := matchSubject(a.value)
+	if !ok {
+		a.consumeNegation()
+		a.fail("MatchRegexp requires a string value, got %T", a.value)
+		return a
+	}
+	re := compileRegexpPattern(pattern)
+	matches := re.MatchString(str)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("string %q should not match pattern %q", str, re.String())
+		}
+		return a
+	}
+	if !matches {
+		a.fail("string %q should match pattern %q", str, re.String())
+	}
+	return a
+}
+
+// NotMatchRegexp checks that the asserted value does not match pattern.
+// The asserted value must be a string, []byte, or fmt.Stringer; pattern
+// may be a string (compiled lazily) or a pre-compiled *regexp.Regexp.
+// Panics if the value isn't a matchable subject, pattern fails to
+// compile, or the subject matches. A thin wrapper over Not().MatchRegexp().
+func (a *Assertion) NotMatchRegexp(pattern any) *Assertion {
+	return a.Not().MatchRegexp(pattern)
+}
+
+// Implement checks that the asserted value's type satisfies the
+// interface recovered from ifacePtr, a typed nil pointer to that
+// interface (e.g. (*io.Reader)(nil)). Panics if ifacePtr isn't a pointer
+// to an interface type, if the asserted value is nil, or if its type
+// (including via a pointer receiver) doesn't implement the interface.
+// Honors Not(): Should(x).Not().Implement(ifacePtr) fails if x's type
+// implements the interface.
+func (a *Assertion) Implement(ifacePtr any) *Assertion {
+	ifaceType := reflect.TypeOf(ifacePtr)
+	if ifaceType == nil || ifaceType.Kind() != reflect.Ptr || ifaceType.Elem().Kind() != reflect.Interface {
+		panic(fmt.Sprintf("internal bumert error: Implement requires a pointer to an interface, got %T", ifacePtr))
+	}
+	iface := ifaceType.Elem()
+
+	if isNil(a.value) {
+		a.consumeNegation()
+		a.fail("nil cannot implement %s", iface)
+		return a
+	}
+
+	valType := reflect.TypeOf(a.value)
+	implements := valType.Implements(iface) || reflect.PtrTo(valType).Implements(iface)
+	if a.consumeNegation() {
+		if implements {
+			a.fail("type %s should not implement %s", valType, iface)
+		}
+		return a
+	}
+	if !implements {
+		a.fail("type %s should implement %s", valType, iface)
+	}
+	return a
+}
+
+// BeAssignableTo checks that the asserted value's type is assignable to
+// target's type, per reflect.Type.AssignableTo. Panics if the asserted
+// value is nil or its type isn't assignable to target's type. Honors
+// Not(): Should(x).Not().BeAssignableTo(target) fails if x's type is
+// assignable to target's type.
+func (a *Assertion) BeAssignableTo(target any) *Assertion {
+	if isNil(a.value) {
+		a.consumeNegation()
+		a.fail("nil cannot be assignable to %T", target)
+		return a
+	}
+
+	valType := reflect.TypeOf(a.value)
+	targetType := reflect.TypeOf(target)
+	assignable := valType.AssignableTo(targetType)
+	if a.consumeNegation() {
+		if assignable {
+			a.fail("type %s should not be assignable to %s", valType, targetType)
+		}
+		return a
+	}
+	if !assignable {
+		a.fail("type %s should be assignable to %s", valType, targetType)
+	}
+	return a
+}
+
+// BeJSONEqual checks that the asserted JSON string is structurally equal
+// to expectedJSON: both are unmarshalled into `any` and compared with
+// reflect.DeepEqual, so key order and whitespace don't matter.
+// Panics if the value isn't a string, either side fails to parse, or the
+// decoded values differ.
+// Honors Not(): Should(x).Not().BeJSONEqual(j) fails if x is JSON-equal to j.
+func (a *Assertion) BeJSONEqual(expectedJSON string) *Assertion {
+	str, ok := a.value.(string)
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeJSONEqual requires a string value, got %T", a.value)
+		return a
+	}
+	var gotVal, expVal any
+	if err := json.Unmarshal([]byte(str), &gotVal); err != nil {
+		a.consumeNegation()
+		a.fail("BeJSONEqual: value is not valid JSON: %v", err)
+		return a
+	}
+	if err := json.Unmarshal([]byte(expectedJSON), &expVal); err != nil {
+		a.consumeNegation()
+		a.fail("BeJSONEqual: expected is not valid JSON: %v", err)
+		return a
+	}
+	eq := reflect.DeepEqual(gotVal, expVal)
+	if a.consumeNegation() {
+		if eq {
+			a.fail("should not be JSON-equal, but got: %s", dump(gotVal, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !eq {
+		msg := fmt.Sprintf("should be JSON-equal:\n  expected: %s\n       got: %s",
+			dump(expVal, DefaultDiffConfig), dump(gotVal, DefaultDiffConfig))
+		if diff := diffValues(expVal, gotVal, DefaultDiffConfig); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s", msg)
+	}
+	return a
+}
+
+// BeYAMLEqual checks that the asserted YAML string is structurally equal
+// to expectedYAML, the same way BeJSONEqual compares JSON. bumert ships
+// with no YAML dependency of its own, so this requires a decoder
+// installed via SetYAMLUnmarshaler first.
+// Panics if no unmarshaler is installed, the value isn't a string, either
+// side fails to parse, or the decoded values differ.
+// Honors Not(): Should(x).Not().BeYAMLEqual(y) fails if x is YAML-equal to y.
+func (a *Assertion) BeYAMLEqual(expectedYAML string) *Assertion {
+	if yamlUnmarshal == nil {
+		a.consumeNegation()
+		a.fail("BeYAMLEqual requires a YAML unmarshaler; call SetYAMLUnmarshaler first")
+		return a
+	}
+	str, ok := a.value.(string)
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeYAMLEqual requires a string value, got %T", a.value)
+		return a
+	}
+	var gotVal, expVal any
+	if err := yamlUnmarshal([]byte(str), &gotVal); err != nil {
+		a.consumeNegation()
+		a.fail("BeYAMLEqual: value is not valid YAML: %v", err)
+		return a
+	}
+	if err := yamlUnmarshal([]byte(expectedYAML), &expVal); err != nil {
+		a.consumeNegation()
+		a.fail("BeYAMLEqual: expected is not valid YAML: %v", err)
+		return a
+	}
+	eq := reflect.DeepEqual(gotVal, expVal)
+	if a.consumeNegation() {
+		if eq {
+			a.fail("should not be YAML-equal, but got: %s", dump(gotVal, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !eq {
+		a.fail("should be YAML-equal:\n  expected: %s\n       got: %s",
+			dump(expVal, DefaultDiffConfig), dump(gotVal, DefaultDiffConfig))
+	}
+	return a
+}
+
+// BeWithinDuration checks that the asserted time.Time is within delta of
+// expected, in either direction.
+// Panics if the value isn't a time.Time or the difference exceeds delta.
+// Honors Not(): Should(x).Not().BeWithinDuration(e, d) fails if x falls
+// within delta of e.
+func (a *Assertion) BeWithinDuration(expected time.Time, delta time.Duration) *Assertion {
+	got, ok := a.value.(time.Time)
+	if !ok {
+		a.consumeNegation()
+		a.fail("BeWithinDuration requires a time.Time value, got %T", a.value)
+		return a
+	}
+	diff := got.Sub(expected)
+	if diff < 0 {
+		diff = -diff
+	}
+	pass := diff <= delta
+	if a.consumeNegation() {
+		if pass {
+			a.fail("should not be within %v of %v, but got %v (diff %v)", delta, expected, got, diff)
+		}
+		return a
+	}
+	if !pass {
+		a.fail("should be within %v of %v, but got %v (diff %v)", delta, expected, got, diff)
+	}
+	return a
+}
+
+// isSliceOrArray reports whether v is a valid slice or array value.
+func isSliceOrArray(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+}
+
+// elementsMatch reports whether got and expected (both slices/arrays)
+// contain the same elements as a multiset, via reflect.DeepEqual.
+func elementsMatch(gotVal, expVal reflect.Value) bool {
+	if gotVal.Len() != expVal.Len() {
+		return false
+	}
+	used := make([]bool, expVal.Len())
+	for i := 0; i < gotVal.Len(); i++ {
+		elem := gotVal.Index(i).Interface()
+		matched := false
+		for j := 0; j < expVal.Len(); j++ {
+			if used[j] {
+				continue
+			}
+			if reflect.DeepEqual(elem, expVal.Index(j).Interface()) {
+				used[j] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// BeElementsMatch checks that the asserted slice/array contains the same
+// elements as expected, possibly in a different order (a multiset
+// comparison via reflect.DeepEqual on each element).
+// Panics if either side isn't a slice/array, their lengths differ, or
+// their elements don't match one-to-one.
+// Honors Not(): Should(x).Not().BeElementsMatch(e) fails if x and e
+// contain the same elements.
+func (a *Assertion) BeElementsMatch(expected any) *Assertion {
+	gotVal, expVal := reflect.ValueOf(a.value), reflect.ValueOf(expected)
+	if !isSliceOrArray(gotVal) || !isSliceOrArray(expVal) {
+		a.consumeNegation()
+		a.fail("BeElementsMatch requires slice/array values, got %T and %T", a.value, expected)
+		return a
+	}
+	matches := elementsMatch(gotVal, expVal)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("should not have matching elements, but got %s, expected %s",
+				dump(a.value, DefaultDiffConfig), dump(expected, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !matches {
+		if gotVal.Len() != expVal.Len() {
+			a.fail("should have matching elements: length %d != %d (got %s, expected %s)",
+				gotVal.Len(), expVal.Len(), dump(a.value, DefaultDiffConfig), dump(expected, DefaultDiffConfig))
+			return a
+		}
+		a.fail("should have matching elements, but got %s, expected %s",
+			dump(a.value, DefaultDiffConfig), dump(expected, DefaultDiffConfig))
+	}
+	return a
+}
+
+// ContainKey checks that the asserted map has key among its keys.
+// Panics if the value isn't a map or key doesn't appear. Honors Not():
+// Should(x).Not().ContainKey(k) is equivalent to NotContainKey(k).
+func (a *Assertion) ContainKey(key any) *Assertion {
+	v := reflect.ValueOf(a.value)
+	if v.Kind() != reflect.Map {
+		a.consumeNegation()
+		a.fail("ContainKey requires a map, got %T", a.value)
+		return a
+	}
+	kv := reflect.ValueOf(key)
+	if !kv.IsValid() || !kv.Type().AssignableTo(v.Type().Key()) {
+		a.consumeNegation()
+		a.fail("ContainKey key type %T not assignable to map key type %s", key, v.Type().Key())
+		return a
+	}
+	present := v.MapIndex(kv).IsValid()
+	if a.consumeNegation() {
+		if present {
+			a.fail("map %s should not contain key %s", dump(a.value, DefaultDiffConfig), dump(key, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !present {
+		a.fail("map %s should contain key %s", dump(a.value, DefaultDiffConfig), dump(key, DefaultDiffConfig))
+	}
+	return a
+}
+
+// NotContainKey checks that the asserted map does not have key among its
+// keys.
+// Panics if the value isn't a map or key is present. A thin wrapper over
+// Not().ContainKey().
+func (a *Assertion) NotContainKey(key any) *Assertion {
+	return a.Not().ContainKey(key)
+}
+
+// ContainValue checks that the asserted map has value among its values,
+// compared with reflect.DeepEqual.
+// Panics if the value isn't a map or no entry matches. Honors Not():
+// Should(x).Not().ContainValue(v) fails if x has a matching value.
+func (a *Assertion) ContainValue(value any) *Assertion {
+	v := reflect.ValueOf(a.value)
+	if v.Kind() != reflect.Map {
+		a.consumeNegation()
+		a.fail("ContainValue requires a map, got %T", a.value)
+		return a
+	}
+	found := false
+	iter := v.MapRange()
+	for iter.Next() {
+		if reflect.DeepEqual(iter.Value().Interface(), value) {
+			found = true
+			break
+		}
+	}
+	if a.consumeNegation() {
+		if found {
+			a.fail("map %s should not contain value %s", dump(a.value, DefaultDiffConfig), dump(value, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !found {
+		a.fail("map %s should contain value %s", dump(a.value, DefaultDiffConfig), dump(value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// HaveKeyWithValue checks that the asserted map has key present with a
+// value equal to value, per reflect.DeepEqual. The failure message
+// distinguishes a missing key from a key present with a mismatched
+// value, rendering the latter with the BeEqual diff renderer.
+// Panics if the value isn't a map, the key type is incompatible, the key
+// is missing, or the key's value doesn't match. Honors Not():
+// Should(x).Not().HaveKeyWithValue(k, v) fails if x has key k with value v.
+func (a *Assertion) HaveKeyWithValue(key, value any) *Assertion {
+	v := reflect.ValueOf(a.value)
+	if v.Kind() != reflect.Map {
+		a.consumeNegation()
+		a.fail("HaveKeyWithValue requires a map, got %T", a.value)
+		return a
+	}
+	kv := reflect.ValueOf(key)
+	if !kv.IsValid() || !kv.Type().AssignableTo(v.Type().Key()) {
+		a.consumeNegation()
+		a.fail("HaveKeyWithValue key type %T not assignable to map key type %s", key, v.Type().Key())
+		return a
+	}
+	got := v.MapIndex(kv)
+	if !got.IsValid() {
+		if a.consumeNegation() {
+			return a
+		}
+		a.fail("map %s should contain key %s", dump(a.value, DefaultDiffConfig), dump(key, DefaultDiffConfig))
+		return a
+	}
+	gotVal := got.Interface()
+	eq := reflect.DeepEqual(gotVal, value)
+	if a.consumeNegation() {
+		if eq {
+			a.fail("map should not have key %s with value %s", dump(key, DefaultDiffConfig), dump(value, DefaultDiffConfig))
+		}
+		return a
+	}
+	if !eq {
+		msg := fmt.Sprintf("map should have key %s with value %s, but got %s",
+			dump(key, DefaultDiffConfig), dump(value, DefaultDiffConfig), dump(gotVal, DefaultDiffConfig))
+		if diff := diffValues(value, gotVal, DefaultDiffConfig); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s", msg)
+	}
+	return a
+}
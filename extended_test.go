@@ -0,0 +1,507 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestAssertion_BeInDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    float64
+		delta       float64
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"within delta", 1.02, 1.0, 0.05, false, ""},
+		{"comfortably within delta", 1.04, 1.0, 0.05, false, ""},
+		{"outside delta", 1.2, 1.0, 0.05, true, "should be within delta"},
+		{"int value converts", 10, 10.0, 0.0, false, ""},
+		{"non-numeric value", "nope", 1.0, 0.05, true, "requires a numeric value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeInDelta(tt.expected, tt.delta) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeInEpsilon(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    float64
+		epsilon     float64
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"within epsilon", 105.0, 100.0, 0.1, false, ""},
+		{"outside epsilon", 120.0, 100.0, 0.1, true, "should be within epsilon"},
+		{"expected is zero", 1.0, 0.0, 0.1, true, "undefined when expected is zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeInEpsilon(tt.expected, tt.epsilon) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeWithinDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    any
+		delta       float64
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"comfortably within delta", 1.04, 1.0, 0.05, false, ""},
+		{"outside delta", 1.1, 1.0, 0.05, true, "should be within delta"},
+		{"mixed numeric types", 10, 9.5, 1.0, false, ""},
+		{"Inf vs Inf same sign", math.Inf(1), math.Inf(1), 0, false, ""},
+		{"Inf vs Inf opposite sign", math.Inf(1), math.Inf(-1), 0, true, "should be within delta"},
+		{"NaN value", math.NaN(), 1.0, 0.05, true, "NaN cannot be compared"},
+		{"NaN expected", 1.0, math.NaN(), 0.05, true, "NaN cannot be compared"},
+		{"incompatible types (string vs float)", "1.0", 1.0, 0.05, true, "requires comparable numeric types"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeWithinDelta(tt.expected, tt.delta) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeWithinEpsilon(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    any
+		epsilon     float64
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"within epsilon", 104, 100, 0.05, false, ""},
+		{"outside epsilon", 110, 100, 0.05, true, "should be within epsilon"},
+		{"mixed numeric types", 10, 9.5, 0.2, false, ""},
+		{"zero expected", 1.0, 0.0, 0.05, true, "epsilon comparison undefined when expected is zero"},
+		{"Inf vs Inf same sign", math.Inf(1), math.Inf(1), 0, false, ""},
+		{"NaN value", math.NaN(), 1.0, 0.05, true, "NaN cannot be compared"},
+		{"NaN expected", 1.0, math.NaN(), 0.05, true, "NaN cannot be compared"},
+		{"incompatible types (struct vs int)", struct{}{}, 0, 0.05, true, "requires comparable numeric types"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeWithinEpsilon(tt.expected, tt.epsilon) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+// stringerID is a minimal fmt.Stringer used to exercise MatchRegexp's
+// support for fmt.Stringer subjects.
+type stringerID int
+
+func (s stringerID) String() string { return fmt.Sprintf("id-%d", int(s)) }
+
+func TestAssertion_MatchRegexp(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		pattern     any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"matches", "hello123", `^hello\d+$`, false, ""},
+		{"does not match", "hello", `^\d+$`, true, "should match pattern"},
+		{"empty pattern matches anything", "hello", ``, false, ""},
+		{"invalid pattern", "hello", `(`, true, "invalid regexp pattern"},
+		{"non-string value", 42, `\d+`, true, "requires a string value"},
+		{"[]byte subject", []byte("hello123"), `^hello\d+$`, false, ""},
+		{"fmt.Stringer subject", stringerID(42), `^id-\d+$`, false, ""},
+		{"precompiled pattern", "hello123", regexp.MustCompile(`^hello\d+$`), false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).MatchRegexp(tt.pattern) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_NotMatchRegexp(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		pattern     any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"does not match", "hello", `^\d+$`, false, ""},
+		{"matches", "hello123", `^hello\d+$`, true, "should not match pattern"},
+		{"[]byte subject", []byte("hello"), `^\d+$`, false, ""},
+		{"fmt.Stringer subject", stringerID(42), `^id-\d+$`, true, "should not match pattern"},
+		{"precompiled pattern", "hello", regexp.MustCompile(`^\d+$`), false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).NotMatchRegexp(tt.pattern) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+type writerImpl struct{}
+
+func (writerImpl) Write(p []byte) (int, error) { return len(p), nil }
+
+type readerPtrImpl struct{}
+
+func (*readerPtrImpl) Read(p []byte) (int, error) { return 0, nil }
+
+func TestAssertion_Implement(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		ifacePtr    any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"value implements interface", writerImpl{}, (*io.Writer)(nil), false, ""},
+		{"pointer-receiver implements interface", &readerPtrImpl{}, (*io.Reader)(nil), false, ""},
+		{"value does not implement interface", 42, (*io.Writer)(nil), true, "should implement"},
+		{"nil value", nil, (*io.Writer)(nil), true, "nil cannot implement"},
+		{"typed nil value", (*readerPtrImpl)(nil), (*io.Writer)(nil), true, "nil cannot implement"},
+		{"non-interface-pointer argument", writerImpl{}, io.Writer(nil), true, "Implement requires a pointer to an interface"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).Implement(tt.ifacePtr) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeAssignableTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		target      any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"assignable", 42, 0, false, ""},
+		{"assignable named types", myInt(1), myInt(0), false, ""},
+		{"not assignable", 42, "", true, "should be assignable to"},
+		{"nil value", nil, 0, true, "nil cannot be assignable to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeAssignableTo(tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+type myInt int
+
+func TestAssertion_BeJSONEqual(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    string
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"equal ignoring whitespace/order", `{"a": 1, "b": 2}`, `{"b":2,"a":1}`, false, ""},
+		{"not equal", `{"a": 1}`, `{"a": 2}`, true, "should be JSON-equal"},
+		{"invalid got JSON", `{not json`, `{}`, true, "value is not valid JSON"},
+		{"invalid expected JSON", `{}`, `{not json`, true, "expected is not valid JSON"},
+		{"non-string value", 42, `42`, true, "requires a string value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeJSONEqual(tt.expected) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeYAMLEqual_NoUnmarshaler(t *testing.T) {
+	assertPanics(t, func() {
+		bumert.Should("a: 1").BeYAMLEqual("a: 1")
+	}, "requires a YAML unmarshaler")
+}
+
+func TestAssertion_SetYAMLUnmarshaler(t *testing.T) {
+	bumert.SetYAMLUnmarshaler(func(data []byte, v any) error {
+		// Minimal stand-in decoder for "key: value" lines, just enough to
+		// exercise BeYAMLEqual without a real YAML dependency.
+		m := map[string]any{}
+		for _, line := range splitLines(string(data)) {
+			if k, val, ok := splitOnce(line, ": "); ok {
+				m[k] = val
+			}
+		}
+		*(v.(*any)) = m
+		return nil
+	})
+	defer bumert.SetYAMLUnmarshaler(nil)
+
+	assertNotPanics(t, func() { bumert.Should("a: 1").BeYAMLEqual("a: 1") })
+	assertPanics(t, func() { bumert.Should("a: 1").BeYAMLEqual("a: 2") }, "should be YAML-equal")
+}
+
+func splitLines(s string) []string {
+	var out []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			out = append(out, s[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		out = append(out, s[start:])
+	}
+	return out
+}
+
+func splitOnce(s, sep string) (string, string, bool) {
+	for i := 0; i+len(sep) <= len(s); i++ {
+		if s[i:i+len(sep)] == sep {
+			return s[:i], s[i+len(sep):], true
+		}
+	}
+	return "", "", false
+}
+
+func TestAssertion_BeWithinDuration(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		value       any
+		expected    time.Time
+		delta       time.Duration
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"within duration", base.Add(2 * time.Second), base, 5 * time.Second, false, ""},
+		{"outside duration, future", base.Add(10 * time.Second), base, 5 * time.Second, true, "should be within"},
+		{"outside duration, past", base.Add(-10 * time.Second), base, 5 * time.Second, true, "should be within"},
+		{"non-time value", "nope", base, 5 * time.Second, true, "requires a time.Time value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeWithinDuration(tt.expected, tt.delta) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_BeElementsMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"same elements, different order", []int{1, 2, 3}, []int{3, 1, 2}, false, ""},
+		{"duplicate elements match counts", []int{1, 1, 2}, []int{1, 2, 1}, false, ""},
+		{"length mismatch", []int{1, 2}, []int{1, 2, 3}, true, "length"},
+		{"different elements", []int{1, 2, 3}, []int{1, 2, 4}, true, "should have matching elements"},
+		{"non-slice value", 42, []int{1}, true, "requires slice/array values"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).BeElementsMatch(tt.expected) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_ContainKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	tests := []struct {
+		name        string
+		value       any
+		key         any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"key present", m, "a", false, ""},
+		{"key absent", m, "z", true, "should contain key"},
+		{"not a map", 42, "a", true, "requires a map"},
+		{"interface-keyed map, assignable key", map[any]int{"a": 1, 2: 3}, "a", false, ""},
+		{"interface-keyed map, key absent", map[any]int{"a": 1, 2: 3}, "z", true, "should contain key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).ContainKey(tt.key) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_ContainValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	tests := []struct {
+		name        string
+		value       any
+		target      any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"value present", m, 2, false, ""},
+		{"value absent", m, 99, true, "should contain value"},
+		{"not a map", 42, 1, true, "requires a map"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).ContainValue(tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_NotContainKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	var nilMap map[string]int
+	var typedNilMap map[string]int = nil
+
+	tests := []struct {
+		name        string
+		value       any
+		key         any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"key absent", m, "z", false, ""},
+		{"key present", m, "a", true, "should not contain key"},
+		{"nil map", nilMap, "a", false, ""},
+		{"typed nil map", typedNilMap, "a", false, ""},
+		{"not a map", 42, "a", true, "requires a map"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).NotContainKey(tt.key) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_HaveKeyWithValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	var nilMap map[string]int
+
+	tests := []struct {
+		name        string
+		value       any
+		key         any
+		target      any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"key and value match", m, "a", 1, false, ""},
+		{"key present, value mismatch", m, "a", 2, true, "should have key"},
+		{"key absent", m, "z", 1, true, "should contain key"},
+		{"nil map", nilMap, "a", 1, true, "should contain key"},
+		{"not a map", 42, "a", 1, true, "requires a map"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).HaveKeyWithValue(tt.key, tt.target) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
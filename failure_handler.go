@@ -0,0 +1,54 @@
+package bumert
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+// failureHandler, when set, is invoked with the fully formatted failure
+// message instead of panicking. It is stored behind an atomic pointer so
+// SetFailureHandler can be called safely from concurrent tests. Declared
+// without a build tag so it links into both debug and release builds,
+// even though only the debug build ever reads it.
+var failureHandler atomic.Pointer[func(string)]
+
+// SetFailureHandler installs a package-wide hook that assertion failures
+// report through instead of panicking. This is what lets bumert double
+// as a test-assertion library: install a handler that forwards to
+// t.Fatalf and every Should(...) failure becomes a normal test failure
+// instead of a panic. Passing nil restores the default panic behavior.
+//
+// For binding a single test without touching global state, prefer
+// For(tb).Should(v) instead.
+func SetFailureHandler(handler func(msg string)) {
+	if handler == nil {
+		failureHandler.Store(nil)
+		return
+	}
+	failureHandler.Store(&handler)
+}
+
+// UseTestingT installs a package-wide failure handler that routes every
+// assertion failure through tb.Fatalf instead of panicking. The handler
+// calls tb.Helper(), which marks only the handler itself, so the location
+// testing prints for the failure is a bumert frame; the caller's own
+// file:line is the prefix of the message. It's a convenience for
+// SetFailureHandler(func(msg string) { tb.Helper(); tb.Fatalf(msg) }).
+//
+// It registers a tb.Cleanup that restores whatever handler was installed
+// before the call (nil, i.e. the default panic behavior, if none was),
+// so the handler doesn't outlive tb's test and start calling Fatalf on a
+// *testing.T whose test has already completed.
+//
+// For binding a single assertion chain instead of every Should(...) in
+// the package, prefer For(tb).Should(v).
+func UseTestingT(tb testing.TB) {
+	previous := failureHandler.Load()
+	tb.Cleanup(func() {
+		failureHandler.Store(previous)
+	})
+	SetFailureHandler(func(msg string) {
+		tb.Helper()
+		tb.Fatalf("%s", msg)
+	})
+}
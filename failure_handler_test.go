@@ -0,0 +1,68 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestSetFailureHandler verifies that installing a failure handler routes
+// assertion failures through it instead of panicking, and that restoring
+// it to nil brings back the default panic behavior.
+func TestSetFailureHandler(t *testing.T) {
+	var captured string
+	bumert.SetFailureHandler(func(msg string) {
+		captured = msg
+	})
+	defer bumert.SetFailureHandler(nil)
+
+	assertNotPanics(t, func() { bumert.Should(1).BeGreaterThan(10) })
+
+	if !strings.Contains(captured, "should be greater than") {
+		t.Errorf("handler did not receive the assertion message, got: %q", captured)
+	}
+
+	bumert.SetFailureHandler(nil)
+	assertPanics(t, func() { bumert.Should(1).BeGreaterThan(10) }, "should be greater than")
+}
+
+// TestUseTestingT verifies that UseTestingT installs a package-wide
+// handler routing failures through tb.Fatalf, with no panic propagating,
+// and that it restores the previous handler once tb's cleanups run.
+func TestUseTestingT(t *testing.T) {
+	tb := &mockTB{}
+	bumert.UseTestingT(tb)
+
+	runIsolated(func() { bumert.Should(1).BeGreaterThan(10) })
+
+	if len(tb.fatalfCalls) != 1 {
+		t.Fatalf("expected exactly one Fatalf call, got: %v", tb.fatalfCalls)
+	}
+	if !strings.Contains(tb.fatalfCalls[0], "should be greater than") {
+		t.Errorf("unexpected Fatalf message: %q", tb.fatalfCalls[0])
+	}
+
+	tb.runCleanups()
+	assertPanics(t, func() { bumert.Should(1).BeGreaterThan(10) }, "should be greater than")
+}
+
+// TestUseTestingT_RestoresPreviousHandler verifies that UseTestingT's
+// cleanup restores whatever handler was installed before it ran, rather
+// than unconditionally resetting to the default panic behavior.
+func TestUseTestingT_RestoresPreviousHandler(t *testing.T) {
+	var captured string
+	bumert.SetFailureHandler(func(msg string) { captured = msg })
+	defer bumert.SetFailureHandler(nil)
+
+	tb := &mockTB{}
+	bumert.UseTestingT(tb)
+	tb.runCleanups()
+
+	assertNotPanics(t, func() { bumert.Should(1).BeGreaterThan(10) })
+	if !strings.Contains(captured, "should be greater than") {
+		t.Errorf("previous handler was not restored, got: %q", captured)
+	}
+}
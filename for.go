@@ -0,0 +1,419 @@
+package bumert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+// TFor is the builder returned by For. Should starts an assertion chain
+// against a value; Must/Require switch that chain to report failures via
+// t.Fatalf instead of t.Errorf.
+type TFor struct {
+	tb       testing.TB
+	required bool
+}
+
+// For starts a testing.TB-backed assertion chain: bumert.For(t).Should(v)...
+// returns the same kind of fluent surface as Should, but failures are
+// always reported through t (t.Errorf by default, t.Fatalf after
+// Must/Require) instead of panicking. Unlike Should, this runs in every
+// build -- debug or release -- so bumert can double as a plain unit-test
+// assertion library without the debug/bumert build tag.
+//
+// For binds one assertion chain to a *testing.T without touching package
+// state; UseTestingT and SetFailureHandler instead reroute every
+// Should(v) failure in the package. Should(v) on its own is the
+// zero-cost invariant checker that panics (or, inside a Group,
+// aggregates).
+func For(tb testing.TB) *TFor {
+	return &TFor{tb: tb}
+}
+
+// Must switches the chain to call t.Fatalf on failure instead of t.Errorf.
+func (f *TFor) Must() *TFor {
+	f.required = true
+	return f
+}
+
+// Require is an alias for Must.
+func (f *TFor) Require() *TFor {
+	return f.Must()
+}
+
+// Should starts an assertion chain against value.
+func (f *TFor) Should(value any) *TAssertion {
+	return &TAssertion{tb: f.tb, value: value, required: f.required}
+}
+
+// TAssertion is the assertion surface returned by For(tb).Should(value).
+// Each check reports through the bound testing.TB rather than panicking.
+type TAssertion struct {
+	tb       testing.TB
+	value    any
+	required bool
+}
+
+func (a *TAssertion) fail(format string, args ...any) *TAssertion {
+	a.tb.Helper()
+	msg := fmt.Sprintf(format, args...)
+	if a.required {
+		a.tb.Fatalf("assertion failed: %s", msg)
+	} else {
+		a.tb.Errorf("assertion failed: %s", msg)
+	}
+	return a
+}
+
+// tIsNil reports whether value is nil, including a typed nil pointer,
+// slice, map, chan, func, or interface. It mirrors isNil from the
+// debug-mode Assertion, kept as a separate copy here since this file
+// compiles in every build, debug or release.
+func tIsNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// tGetLength reports the length of value if it supports len(), mirroring
+// getLength from the debug-mode Assertion.
+func tGetLength(value any) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// tConvertToFloat64 converts a numeric reflect.Value to float64,
+// mirroring convertToFloat64 from the debug-mode Assertion. Kept as a
+// separate copy here since this file compiles in every build, debug or
+// release.
+func tConvertToFloat64(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// BeNil checks that the asserted value is nil.
+func (a *TAssertion) BeNil() *TAssertion {
+	if !tIsNil(a.value) {
+		a.fail("should be nil, but got: %s", dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// NotBeNil checks that the asserted value is not nil.
+func (a *TAssertion) NotBeNil() *TAssertion {
+	if tIsNil(a.value) {
+		a.fail("should not be nil")
+	}
+	return a
+}
+
+// BeTrue checks that the asserted value is the boolean true.
+func (a *TAssertion) BeTrue() *TAssertion {
+	b, ok := a.value.(bool)
+	if !ok || !b {
+		a.fail("should be true, but got: %s", dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// BeFalse checks that the asserted value is the boolean false.
+func (a *TAssertion) BeFalse() *TAssertion {
+	b, ok := a.value.(bool)
+	if !ok || b {
+		a.fail("should be false, but got: %s", dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// BeEqual checks that the asserted value deep-equals expected.
+func (a *TAssertion) BeEqual(expected any) *TAssertion {
+	if !reflect.DeepEqual(a.value, expected) {
+		msg := fmt.Sprintf("should be equal:\n  expected: %s\n       got: %s",
+			dump(expected, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+		if diff := diffValues(expected, a.value, DefaultDiffConfig); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s", msg)
+	}
+	return a
+}
+
+// NotBeEqual checks that the asserted value does not deep-equal unexpected.
+func (a *TAssertion) NotBeEqual(unexpected any) *TAssertion {
+	if reflect.DeepEqual(a.value, unexpected) {
+		a.fail("should not be equal, but got: %s", dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// BeEmpty checks that the asserted value has length zero.
+func (a *TAssertion) BeEmpty() *TAssertion {
+	length, ok := tGetLength(a.value)
+	if !ok {
+		a.fail("BeEmpty requires a value with a length, got %T", a.value)
+		return a
+	}
+	if length != 0 {
+		a.fail("should be empty, but got: %s", dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// NotBeEmpty checks that the asserted value has a non-zero length.
+func (a *TAssertion) NotBeEmpty() *TAssertion {
+	length, ok := tGetLength(a.value)
+	if !ok {
+		a.fail("NotBeEmpty requires a value with a length, got %T", a.value)
+		return a
+	}
+	if length == 0 {
+		a.fail("should not be empty")
+	}
+	return a
+}
+
+// HaveLen checks that the asserted value has length expectedLen.
+func (a *TAssertion) HaveLen(expectedLen int) *TAssertion {
+	length, ok := tGetLength(a.value)
+	if !ok {
+		a.fail("HaveLen requires a value with a length, got %T", a.value)
+		return a
+	}
+	if length != expectedLen {
+		a.fail("should have length %d, but got length %d: %s", expectedLen, length, dump(a.value, DefaultDiffConfig))
+	}
+	return a
+}
+
+// Contain checks that the asserted slice, array, map, or string contains
+// expectedElement (as a substring, for strings).
+func (a *TAssertion) Contain(expectedElement any) *TAssertion {
+	if s, ok := a.value.(string); ok {
+		sub, ok := expectedElement.(string)
+		if !ok || !strings.Contains(s, sub) {
+			a.fail("should contain %s, but got: %s", dump(expectedElement, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+		}
+		return a
+	}
+	v := reflect.ValueOf(a.value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), expectedElement) {
+				return a
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if reflect.DeepEqual(iter.Value().Interface(), expectedElement) {
+				return a
+			}
+		}
+	default:
+		a.fail("Contain requires a slice, array, map, or string, got %T", a.value)
+		return a
+	}
+	a.fail("should contain %s, but got: %s", dump(expectedElement, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+	return a
+}
+
+// NotContain checks that the asserted slice, array, map, or string does
+// not contain unexpectedElement.
+func (a *TAssertion) NotContain(unexpectedElement any) *TAssertion {
+	if s, ok := a.value.(string); ok {
+		if sub, ok := unexpectedElement.(string); ok && strings.Contains(s, sub) {
+			a.fail("should not contain %s, but got: %s", dump(unexpectedElement, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+		}
+		return a
+	}
+	v := reflect.ValueOf(a.value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), unexpectedElement) {
+				a.fail("should not contain %s, but got: %s", dump(unexpectedElement, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+				return a
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if reflect.DeepEqual(iter.Value().Interface(), unexpectedElement) {
+				a.fail("should not contain %s, but got: %s", dump(unexpectedElement, DefaultDiffConfig), dump(a.value, DefaultDiffConfig))
+				return a
+			}
+		}
+	default:
+		a.fail("NotContain requires a slice, array, map, or string, got %T", a.value)
+	}
+	return a
+}
+
+// BeGreaterThan checks that the asserted numeric value is greater than expected.
+func (a *TAssertion) BeGreaterThan(expected any) *TAssertion {
+	got, ok1 := tConvertToFloat64(reflect.ValueOf(a.value))
+	exp, ok2 := tConvertToFloat64(reflect.ValueOf(expected))
+	if !ok1 || !ok2 {
+		a.fail("BeGreaterThan requires numeric values, got %T and %T", a.value, expected)
+		return a
+	}
+	if !(got > exp) {
+		a.fail("should be greater than %v, but got %v", expected, a.value)
+	}
+	return a
+}
+
+// BeLessThan checks that the asserted numeric value is less than expected.
+func (a *TAssertion) BeLessThan(expected any) *TAssertion {
+	got, ok1 := tConvertToFloat64(reflect.ValueOf(a.value))
+	exp, ok2 := tConvertToFloat64(reflect.ValueOf(expected))
+	if !ok1 || !ok2 {
+		a.fail("BeLessThan requires numeric values, got %T and %T", a.value, expected)
+		return a
+	}
+	if !(got < exp) {
+		a.fail("should be less than %v, but got %v", expected, a.value)
+	}
+	return a
+}
+
+// tRunAndRecover invokes fn and reports whether it panicked, along with
+// the recovered value and the stack trace captured at the point of
+// recovery. Mirrors runAndRecover from the debug-mode Assertion, kept as
+// a separate copy here since this file compiles in every build, debug or
+// release.
+func tRunAndRecover(fn func()) (panicked bool, recovered any, stack string) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			recovered = r
+			stack = string(debug.Stack())
+		}
+	}()
+	fn()
+	return
+}
+
+// Panic checks that the asserted func() panics when called.
+func (a *TAssertion) Panic() *TAssertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.fail("Panic requires a func() value, got %T", a.value)
+		return a
+	}
+	if panicked, _, _ := tRunAndRecover(fn); !panicked {
+		a.fail("should panic, but it did not")
+	}
+	return a
+}
+
+// NotPanic checks that the asserted func() does not panic when called.
+func (a *TAssertion) NotPanic() *TAssertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.fail("NotPanic requires a func() value, got %T", a.value)
+		return a
+	}
+	if panicked, recovered, stack := tRunAndRecover(fn); panicked {
+		a.fail("should not panic, but panicked with %s\n%s", dump(recovered, DefaultDiffConfig), stack)
+	}
+	return a
+}
+
+// PanicWithValue checks that the asserted func() panics with a value
+// equal to expected, per reflect.DeepEqual.
+func (a *TAssertion) PanicWithValue(expected any) *TAssertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.fail("PanicWithValue requires a func() value, got %T", a.value)
+		return a
+	}
+	panicked, recovered, stack := tRunAndRecover(fn)
+	if !panicked {
+		a.fail("should panic with %s, but it did not", dump(expected, DefaultDiffConfig))
+		return a
+	}
+	if !reflect.DeepEqual(recovered, expected) {
+		msg := fmt.Sprintf("should panic with %s, but panicked with %s",
+			dump(expected, DefaultDiffConfig), dump(recovered, DefaultDiffConfig))
+		if diff := diffValues(expected, recovered, DefaultDiffConfig); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s\n%s", msg, stack)
+	}
+	return a
+}
+
+// PanicWithError checks that the asserted func() panics with a value
+// implementing error whose Error() contains substr.
+func (a *TAssertion) PanicWithError(substr string) *TAssertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.fail("PanicWithError requires a func() value, got %T", a.value)
+		return a
+	}
+	panicked, recovered, stack := tRunAndRecover(fn)
+	if !panicked {
+		a.fail("should panic with an error containing %q, but it did not", substr)
+		return a
+	}
+	err, ok := recovered.(error)
+	if !ok {
+		a.fail("should panic with an error, but panicked with %s\n%s", dump(recovered, DefaultDiffConfig), stack)
+		return a
+	}
+	if !strings.Contains(err.Error(), substr) {
+		a.fail("should panic with an error containing %q, but got %q\n%s", substr, err.Error(), stack)
+	}
+	return a
+}
+
+// BeErrorOfType checks that the asserted value is a non-nil error
+// matching target, the same way errors.As does.
+func (a *TAssertion) BeErrorOfType(target any) *TAssertion {
+	err, ok := a.value.(error)
+	if !ok || tIsNil(err) {
+		a.fail("should be a non-nil error, but got: %#v (type %T)", a.value, a.value)
+		return a
+	}
+
+	targetVal := reflect.ValueOf(target)
+	if targetVal.Kind() != reflect.Ptr || targetVal.IsNil() {
+		panic(fmt.Sprintf("internal bumert error: BeErrorOfType target must be a non-nil pointer, got %T", target))
+	}
+
+	if !errors.As(err, target) {
+		targetTypeName := "unknown (check target type)"
+		if targetVal.Type().Elem().Kind() == reflect.Interface {
+			targetTypeName = targetVal.Type().Elem().String()
+		} else if targetVal.Type().Elem().Elem().Kind() != reflect.Invalid {
+			targetTypeName = targetVal.Type().Elem().Elem().String()
+		}
+		a.fail("error type should be %s (or wrap it), but got type %T: %v", targetTypeName, err, err)
+	}
+	return a
+}
@@ -0,0 +1,105 @@
+package bumert_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestFor_Should verifies that For(t).Should reports a failing check
+// through t.Errorf, in every build -- this is the one bumert entry
+// point that doesn't need the debug/bumert build tag.
+func TestFor_Should(t *testing.T) {
+	tb := &mockTB{}
+	bumert.For(tb).Should(5).BeGreaterThan(10)
+	if len(tb.fatalfCalls) != 0 {
+		t.Errorf("Should should never call Fatalf, got: %v", tb.fatalfCalls)
+	}
+	if len(tb.errorfCalls) != 1 {
+		t.Fatalf("expected exactly one Errorf call, got: %v", tb.errorfCalls)
+	}
+
+	tb = &mockTB{}
+	bumert.For(tb).Should(5).BeGreaterThan(0)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("a passing check should not call Errorf, got: %v", tb.errorfCalls)
+	}
+}
+
+// TestFor_Must verifies that Must/Require switch the chain to call
+// t.Fatalf instead of t.Errorf.
+func TestFor_Must(t *testing.T) {
+	tb := &mockTB{}
+	runIsolated(func() { bumert.For(tb).Must().Should(5).BeGreaterThan(10) })
+	if len(tb.fatalfCalls) != 1 {
+		t.Fatalf("expected exactly one Fatalf call, got: %v", tb.fatalfCalls)
+	}
+
+	tb = &mockTB{}
+	runIsolated(func() { bumert.For(tb).Require().Should(5).BeGreaterThan(10) })
+	if len(tb.fatalfCalls) != 1 {
+		t.Fatalf("expected Require to behave like Must, got: %v", tb.fatalfCalls)
+	}
+}
+
+func TestTAssertion_BeNil(t *testing.T) {
+	tb := &mockTB{}
+	bumert.For(tb).Should(nil).BeNil()
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("nil should satisfy BeNil, got: %v", tb.errorfCalls)
+	}
+
+	tb = &mockTB{}
+	bumert.For(tb).Should(42).BeNil()
+	if len(tb.errorfCalls) != 1 {
+		t.Fatalf("expected exactly one Errorf call, got: %v", tb.errorfCalls)
+	}
+}
+
+func TestTAssertion_BeEqual(t *testing.T) {
+	tb := &mockTB{}
+	bumert.For(tb).Should(5).BeEqual(5)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("equal values should satisfy BeEqual, got: %v", tb.errorfCalls)
+	}
+
+	tb = &mockTB{}
+	bumert.For(tb).Should(5).NotBeEqual(6)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("unequal values should satisfy NotBeEqual, got: %v", tb.errorfCalls)
+	}
+}
+
+func TestTAssertion_HaveLenAndContain(t *testing.T) {
+	tb := &mockTB{}
+	bumert.For(tb).Should([]int{1, 2, 3}).HaveLen(3).Contain(2)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("expected no failures, got: %v", tb.errorfCalls)
+	}
+
+	tb = &mockTB{}
+	bumert.For(tb).Should([]int{1, 2, 3}).NotContain(9)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("expected no failures, got: %v", tb.errorfCalls)
+	}
+}
+
+func TestTAssertion_BeErrorOfType(t *testing.T) {
+	var pathErr *os.PathError
+	wrapped := fmt.Errorf("wrapped: %w", &os.PathError{Op: "open", Path: "x", Err: errors.New("boom")})
+
+	tb := &mockTB{}
+	bumert.For(tb).Should(wrapped).BeErrorOfType(&pathErr)
+	if len(tb.errorfCalls) != 0 {
+		t.Errorf("expected no failures, got: %v", tb.errorfCalls)
+	}
+
+	tb = &mockTB{}
+	bumert.For(tb).Should(errors.New("plain")).BeErrorOfType(&pathErr)
+	if len(tb.errorfCalls) != 1 {
+		t.Fatalf("expected exactly one Errorf call, got: %v", tb.errorfCalls)
+	}
+}
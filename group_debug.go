@@ -0,0 +1,76 @@
+//go:build debug || bumert
+
+package bumert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// groupFailure is one failed assertion collected by a Group, along with
+// the caller info of the assertion method that recorded it.
+type groupFailure struct {
+	callerInfo string
+	message    string
+}
+
+// Group collects assertion failures instead of panicking on the first
+// one, so a whole batch of checks (e.g. every field of a config struct)
+// can run and be reported together. Also known as SoftAssertions. A
+// Group is safe for concurrent use: Should/AssertAll/Failed may be called
+// from multiple goroutines, since the code under debug assertions often
+// runs concurrently.
+type Group struct {
+	mu       sync.Mutex
+	failures []groupFailure
+}
+
+// NewGroup creates an empty Group.
+func NewGroup() *Group {
+	return &Group{}
+}
+
+// Should starts an assertion chain bound to g: a failing check appends a
+// record to the group instead of panicking, so later assertions in the
+// same group still run.
+func (g *Group) Should(value any) *Assertion {
+	return &Assertion{value: value, group: g}
+}
+
+// record appends a failure to the group. Called by Assertion.fail.
+func (g *Group) record(callerInfo, message string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures = append(g.failures, groupFailure{callerInfo, message})
+}
+
+// snapshot returns a copy of the failures recorded so far.
+func (g *Group) snapshot() []groupFailure {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]groupFailure(nil), g.failures...)
+}
+
+// Failed reports whether any assertion in the group has failed so far.
+func (g *Group) Failed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.failures) > 0
+}
+
+// AssertAll reports every failure collected so far, formatted together,
+// through the same panic/failure-handler path as a single assertion
+// failure. It is a no-op if nothing in the group has failed.
+func (g *Group) AssertAll() {
+	failures := g.snapshot()
+	if len(failures) == 0 {
+		return
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d assertion(s) failed:", len(failures))
+	for _, f := range failures {
+		fmt.Fprintf(&sb, "\n  - %s: %s", f.callerInfo, f.message)
+	}
+	reportFailure(getCallerInfo(2), sb.String())
+}
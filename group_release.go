@@ -0,0 +1,26 @@
+//go:build !debug && !bumert
+
+package bumert
+
+// Group is a no-op struct in release builds.
+type Group struct{}
+
+// NewGroup is a no-op function in release builds.
+func NewGroup() *Group {
+	return &Group{}
+}
+
+// Should is a no-op method in release builds.
+func (g *Group) Should(value any) *Assertion {
+	return &noOpAssertion
+}
+
+// Failed always reports false in release builds, since no check ever fails.
+func (g *Group) Failed() bool {
+	return false
+}
+
+// AssertAll is a no-op method in release builds.
+func (g *Group) AssertAll() {
+	// No-op
+}
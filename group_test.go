@@ -0,0 +1,64 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+// TestGroup_AssertAll_CollectsFailures verifies that a Group defers
+// panicking until AssertAll, and that its message lists every failure
+// collected along the way.
+func TestGroup_AssertAll_CollectsFailures(t *testing.T) {
+	g := bumert.NewGroup()
+
+	assertNotPanics(t, func() {
+		g.Should(1).BeGreaterThan(10)
+		g.Should("foo").HaveLen(10)
+		g.Should(5).BeGreaterThan(0) // passes, should not show up below
+	})
+
+	if !g.Failed() {
+		t.Fatal("expected Failed() to report true after two failing checks")
+	}
+
+	assertPanics(t, g.AssertAll, "2 assertion(s) failed")
+}
+
+// TestGroup_AssertAll_NoFailures verifies that AssertAll is a no-op when
+// every assertion in the group passed.
+func TestGroup_AssertAll_NoFailures(t *testing.T) {
+	g := bumert.NewGroup()
+	g.Should(5).BeGreaterThan(0)
+
+	if g.Failed() {
+		t.Error("expected Failed() to report false when nothing failed")
+	}
+	assertNotPanics(t, g.AssertAll)
+}
+
+// TestGroup_AssertAll_ReportsEachFailure verifies each recorded failure's
+// message appears in the combined panic message.
+func TestGroup_AssertAll_ReportsEachFailure(t *testing.T) {
+	g := bumert.NewGroup()
+	g.Should(1).BeGreaterThan(10)
+	g.Should(2).BeLessThan(0)
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected AssertAll to panic")
+		}
+		msg := r.(string)
+		if !strings.Contains(msg, "should be greater than") {
+			t.Errorf("expected combined message to mention the first failure, got: %q", msg)
+		}
+		if !strings.Contains(msg, "should be less than") {
+			t.Errorf("expected combined message to mention the second failure, got: %q", msg)
+		}
+	}()
+	g.AssertAll()
+}
@@ -0,0 +1,15 @@
+package bumert
+
+// Matcher is a reusable, named check that can be passed to
+// (*Assertion).Match, combined with Not/AllOf/AnyOf, or implemented by
+// callers for their own domain-specific assertions -- inspired by
+// gocheck's Checker interface.
+type Matcher interface {
+	// Check reports whether actual satisfies the matcher. When it
+	// doesn't, msg describes why, to be included in the assertion
+	// failure.
+	Check(actual any) (ok bool, msg string)
+	// Name identifies the matcher in combinator failure messages, e.g.
+	// "AllOf(NotNil, HaveLen(3))".
+	Name() string
+}
@@ -0,0 +1,148 @@
+//go:build debug || bumert
+
+package bumert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// funcMatcher adapts a name and a check function to the Matcher interface.
+type funcMatcher struct {
+	name  string
+	check func(actual any) (bool, string)
+}
+
+func (f funcMatcher) Check(actual any) (bool, string) { return f.check(actual) }
+func (f funcMatcher) Name() string                    { return f.name }
+
+// Match checks the asserted value against m, the way the other Assertion
+// methods check it against a literal expectation.
+// Panics with m's failure message (or a generic one, if m didn't supply
+// one) if m.Check reports false. Honors Not(): Should(x).Not().Match(m)
+// fails if m.Check reports true.
+func (a *Assertion) Match(m Matcher) *Assertion {
+	ok, msg := m.Check(a.value)
+	if a.consumeNegation() {
+		if ok {
+			a.fail("should not match %s", m.Name())
+		}
+		return a
+	}
+	if !ok {
+		if msg == "" {
+			msg = fmt.Sprintf("should match %s", m.Name())
+		}
+		a.fail("%s", msg)
+	}
+	return a
+}
+
+// BeNil returns a Matcher equivalent to (*Assertion).BeNil.
+func BeNil() Matcher {
+	return funcMatcher{
+		name: "BeNil",
+		check: func(actual any) (bool, string) {
+			if isNil(actual) {
+				return true, ""
+			}
+			return false, fmt.Sprintf("should be nil, but got: %s", dump(actual, DefaultDiffConfig))
+		},
+	}
+}
+
+// NotNil returns a Matcher equivalent to (*Assertion).NotBeNil.
+func NotNil() Matcher {
+	return funcMatcher{
+		name: "NotNil",
+		check: func(actual any) (bool, string) {
+			if !isNil(actual) {
+				return true, ""
+			}
+			return false, "should not be nil"
+		},
+	}
+}
+
+// HaveLen returns a Matcher equivalent to (*Assertion).HaveLen.
+func HaveLen(expectedLen int) Matcher {
+	return funcMatcher{
+		name: fmt.Sprintf("HaveLen(%d)", expectedLen),
+		check: func(actual any) (bool, string) {
+			length, ok := getLength(actual)
+			if !ok {
+				return false, fmt.Sprintf("HaveLen requires a value with a length, got %T", actual)
+			}
+			if length != expectedLen {
+				return false, fmt.Sprintf("should have length %d, but got length %d: %s", expectedLen, length, dump(actual, DefaultDiffConfig))
+			}
+			return true, ""
+		},
+	}
+}
+
+// ContainSubstring returns a Matcher equivalent to (*Assertion).ContainSubstring.
+func ContainSubstring(substring string) Matcher {
+	return funcMatcher{
+		name: fmt.Sprintf("ContainSubstring(%q)", substring),
+		check: func(actual any) (bool, string) {
+			s, ok := actual.(string)
+			if !ok || !strings.Contains(s, substring) {
+				return false, fmt.Sprintf("should contain substring %q, but got: %v", substring, actual)
+			}
+			return true, ""
+		},
+	}
+}
+
+// Not inverts m: it matches when m doesn't, and vice versa.
+func Not(m Matcher) Matcher {
+	return funcMatcher{
+		name: fmt.Sprintf("Not(%s)", m.Name()),
+		check: func(actual any) (bool, string) {
+			if ok, _ := m.Check(actual); ok {
+				return false, fmt.Sprintf("should not match %s", m.Name())
+			}
+			return true, ""
+		},
+	}
+}
+
+// AllOf matches when every one of ms matches, short-circuiting (and
+// reporting) on the first that doesn't.
+func AllOf(ms ...Matcher) Matcher {
+	names := make([]string, len(ms))
+	for i, m := range ms {
+		names[i] = m.Name()
+	}
+	return funcMatcher{
+		name: fmt.Sprintf("AllOf(%s)", strings.Join(names, ", ")),
+		check: func(actual any) (bool, string) {
+			for _, m := range ms {
+				if ok, msg := m.Check(actual); !ok {
+					return false, fmt.Sprintf("%s: %s", m.Name(), msg)
+				}
+			}
+			return true, ""
+		},
+	}
+}
+
+// AnyOf matches when at least one of ms matches.
+func AnyOf(ms ...Matcher) Matcher {
+	names := make([]string, len(ms))
+	for i, m := range ms {
+		names[i] = m.Name()
+	}
+	return funcMatcher{
+		name: fmt.Sprintf("AnyOf(%s)", strings.Join(names, ", ")),
+		check: func(actual any) (bool, string) {
+			for _, m := range ms {
+				if ok, _ := m.Check(actual); ok {
+					return true, ""
+				}
+			}
+			return false, fmt.Sprintf("should match at least one of: %s", strings.Join(names, ", "))
+		},
+	}
+}
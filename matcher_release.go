@@ -0,0 +1,51 @@
+//go:build !debug && !bumert
+
+package bumert
+
+// noOpMatcher is a singleton Matcher whose Check always succeeds; it
+// backs every matcher constructor in release builds, where Match never
+// actually invokes it.
+type noOpMatcher struct{}
+
+func (noOpMatcher) Check(actual any) (bool, string) { return true, "" }
+func (noOpMatcher) Name() string                    { return "noop" }
+
+// Match is a no-op method in release builds.
+func (a *Assertion) Match(m Matcher) *Assertion {
+	return a // Return receiver for chainability
+}
+
+// BeNil is a no-op function in release builds.
+func BeNil() Matcher {
+	return noOpMatcher{}
+}
+
+// NotNil is a no-op function in release builds.
+func NotNil() Matcher {
+	return noOpMatcher{}
+}
+
+// HaveLen is a no-op function in release builds.
+func HaveLen(expectedLen int) Matcher {
+	return noOpMatcher{}
+}
+
+// ContainSubstring is a no-op function in release builds.
+func ContainSubstring(substring string) Matcher {
+	return noOpMatcher{}
+}
+
+// Not is a no-op function in release builds.
+func Not(m Matcher) Matcher {
+	return noOpMatcher{}
+}
+
+// AllOf is a no-op function in release builds.
+func AllOf(ms ...Matcher) Matcher {
+	return noOpMatcher{}
+}
+
+// AnyOf is a no-op function in release builds.
+func AnyOf(ms ...Matcher) Matcher {
+	return noOpMatcher{}
+}
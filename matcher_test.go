@@ -0,0 +1,64 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestAssertion_Match(t *testing.T) {
+	assertNotPanics(t, func() { bumert.Should(5).Match(bumert.NotNil()) })
+	assertPanics(t, func() { bumert.Should(nil).Match(bumert.NotNil()) }, "should not be nil")
+	assertPanics(t, func() { bumert.Should(5).Match(bumert.BeNil()) }, "should be nil")
+}
+
+func TestMatcher_AllOf(t *testing.T) {
+	assertNotPanics(t, func() {
+		bumert.Should([]int{1, 2, 3}).Match(bumert.AllOf(bumert.NotNil(), bumert.HaveLen(3)))
+	})
+	assertPanics(t, func() {
+		bumert.Should([]int{1, 2}).Match(bumert.AllOf(bumert.NotNil(), bumert.HaveLen(3)))
+	}, "HaveLen(3)")
+}
+
+func TestMatcher_AnyOf(t *testing.T) {
+	assertNotPanics(t, func() {
+		bumert.Should("hello").Match(bumert.AnyOf(bumert.HaveLen(3), bumert.HaveLen(5)))
+	})
+	assertPanics(t, func() {
+		bumert.Should("hello").Match(bumert.AnyOf(bumert.HaveLen(3), bumert.HaveLen(4)))
+	}, "should match at least one of")
+}
+
+func TestMatcher_Not(t *testing.T) {
+	assertNotPanics(t, func() { bumert.Should(5).Match(bumert.Not(bumert.BeNil())) })
+	assertPanics(t, func() { bumert.Should(nil).Match(bumert.Not(bumert.BeNil())) }, "should not match BeNil")
+}
+
+func TestMatcher_ContainSubstring(t *testing.T) {
+	assertNotPanics(t, func() { bumert.Should("hello world").Match(bumert.ContainSubstring("world")) })
+	assertPanics(t, func() {
+		bumert.Should("hello world").Match(bumert.ContainSubstring("nope"))
+	}, "should contain substring")
+}
+
+// evenMatcher demonstrates implementing the Matcher interface for a
+// domain-specific check, as the interface is intended to support.
+type evenMatcher struct{}
+
+func (evenMatcher) Check(actual any) (bool, string) {
+	n, ok := actual.(int)
+	if !ok || n%2 != 0 {
+		return false, "should be even"
+	}
+	return true, ""
+}
+
+func (evenMatcher) Name() string { return "Even" }
+
+func TestMatcher_CustomMatcher(t *testing.T) {
+	assertNotPanics(t, func() { bumert.Should(4).Match(evenMatcher{}) })
+	assertPanics(t, func() { bumert.Should(3).Match(evenMatcher{}) }, "should be even")
+}
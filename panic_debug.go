@@ -0,0 +1,130 @@
+//go:build debug || bumert
+
+package bumert
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+)
+
+// runAndRecover invokes fn and reports whether it panicked, along with
+// the recovered value and the stack trace captured at the point of
+// recovery.
+func runAndRecover(fn func()) (panicked bool, recovered any, stack string) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			recovered = r
+			stack = string(debug.Stack())
+		}
+	}()
+	fn()
+	return
+}
+
+// Panic checks that the asserted func() panics when called.
+// Panics if the value isn't a func() or calling it doesn't panic. Honors
+// Not(): Should(x).Not().Panic() is equivalent to NotPanic().
+func (a *Assertion) Panic() *Assertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.consumeNegation()
+		a.fail("Panic requires a func() value, got %T", a.value)
+		return a
+	}
+	panicked, recovered, stack := runAndRecover(fn)
+	if a.consumeNegation() {
+		if panicked {
+			a.fail("should not panic, but panicked with %s\n%s", dump(recovered, DefaultDiffConfig), stack)
+		}
+		return a
+	}
+	if !panicked {
+		a.fail("should panic, but it did not")
+	}
+	return a
+}
+
+// NotPanic checks that the asserted func() does not panic when called.
+// Panics if the value isn't a func() or calling it panics. A thin
+// wrapper over Not().Panic().
+func (a *Assertion) NotPanic() *Assertion {
+	return a.Not().Panic()
+}
+
+// PanicWithValue checks that the asserted func() panics with a value
+// equal to expected, per reflect.DeepEqual.
+// Panics if the value isn't a func(), calling it doesn't panic, or the
+// recovered value doesn't match expected. Honors Not():
+// Should(x).Not().PanicWithValue(v) fails if x panics with v.
+func (a *Assertion) PanicWithValue(expected any) *Assertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.consumeNegation()
+		a.fail("PanicWithValue requires a func() value, got %T", a.value)
+		return a
+	}
+	panicked, recovered, stack := runAndRecover(fn)
+	matches := panicked && reflect.DeepEqual(recovered, expected)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("should not panic with %s, but it did\n%s", dump(expected, DefaultDiffConfig), stack)
+		}
+		return a
+	}
+	if !panicked {
+		a.fail("should panic with %s, but it did not", dump(expected, DefaultDiffConfig))
+		return a
+	}
+	if !matches {
+		msg := fmt.Sprintf("should panic with %s, but panicked with %s",
+			dump(expected, DefaultDiffConfig), dump(recovered, DefaultDiffConfig))
+		if diff := diffValues(expected, recovered, DefaultDiffConfig); diff != "" {
+			msg += "\n" + diff
+		}
+		a.fail("%s\n%s", msg, stack)
+	}
+	return a
+}
+
+// PanicWithError checks that the asserted func() panics with a value
+// implementing error whose Error() contains substr.
+// Panics if the value isn't a func(), calling it doesn't panic, the
+// recovered value doesn't implement error, or its message doesn't
+// contain substr. Honors Not(): Should(x).Not().PanicWithError(s) fails
+// if x panics with an error containing s.
+func (a *Assertion) PanicWithError(substr string) *Assertion {
+	fn, ok := a.value.(func())
+	if !ok {
+		a.consumeNegation()
+		a.fail("PanicWithError requires a func() value, got %T", a.value)
+		return a
+	}
+	panicked, recovered, stack := runAndRecover(fn)
+	var err error
+	var isErr bool
+	if panicked {
+		err, isErr = recovered.(error)
+	}
+	matches := isErr && strings.Contains(err.Error(), substr)
+	if a.consumeNegation() {
+		if matches {
+			a.fail("should not panic with an error containing %q, but it did\n%s", substr, stack)
+		}
+		return a
+	}
+	if !panicked {
+		a.fail("should panic with an error containing %q, but it did not", substr)
+		return a
+	}
+	if !isErr {
+		a.fail("should panic with an error, but panicked with %s\n%s", dump(recovered, DefaultDiffConfig), stack)
+		return a
+	}
+	if !matches {
+		a.fail("should panic with an error containing %q, but got %q\n%s", substr, err.Error(), stack)
+	}
+	return a
+}
@@ -0,0 +1,111 @@
+//go:build debug || bumert
+
+package bumert_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deblasis/bumert"
+)
+
+func TestAssertion_Panic(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"panics", func() { panic("boom") }, false, ""},
+		{"does not panic", func() {}, true, "should panic"},
+		{"not a func()", 42, true, "requires a func()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).Panic() }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_NotPanic(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"does not panic", func() {}, false, ""},
+		{"panics", func() { panic("boom") }, true, "should not panic"},
+		{"not a func()", 42, true, "requires a func()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).NotPanic() }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_PanicWithValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		expected    any
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"matching value", func() { panic("boom") }, "boom", false, ""},
+		{"mismatched value", func() { panic("boom") }, "bang", true, "should panic with"},
+		{"does not panic", func() {}, "boom", true, "should panic with"},
+		{"not a func()", 42, "boom", true, "requires a func()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).PanicWithValue(tt.expected) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
+
+func TestAssertion_PanicWithError(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       any
+		substr      string
+		shouldPanic bool
+		panicSubstr string
+	}{
+		{"matching error", func() { panic(errors.New("boom: disk full")) }, "disk full", false, ""},
+		{"mismatched error", func() { panic(errors.New("boom: disk full")) }, "network", true, "should panic with an error containing"},
+		{"non-error panic value", func() { panic("boom") }, "boom", true, "should panic with an error"},
+		{"does not panic", func() {}, "boom", true, "should panic with an error containing"},
+		{"not a func()", 42, "boom", true, "requires a func()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func() { bumert.Should(tt.value).PanicWithError(tt.substr) }
+			if tt.shouldPanic {
+				assertPanics(t, f, tt.panicSubstr)
+			} else {
+				assertNotPanics(t, f)
+			}
+		})
+	}
+}
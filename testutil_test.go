@@ -0,0 +1,59 @@
+package bumert_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+// mockTB is a minimal testing.TB double used to observe whether a
+// testing.TB-backed assertion reports through Errorf or Fatalf without
+// actually failing the real test. Embedding testing.TB satisfies the
+// interface's unexported method; every method we don't override would
+// panic on a nil receiver, so only Helper/Errorf/Fatalf/Cleanup (the ones
+// bumert calls) are implemented.
+type mockTB struct {
+	testing.TB
+	errorfCalls []string
+	fatalfCalls []string
+	cleanups    []func()
+}
+
+func (m *mockTB) Helper() {}
+
+func (m *mockTB) Errorf(format string, args ...any) {
+	m.errorfCalls = append(m.errorfCalls, fmt.Sprintf(format, args...))
+}
+
+func (m *mockTB) Fatalf(format string, args ...any) {
+	m.fatalfCalls = append(m.fatalfCalls, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// Cleanup records f instead of running it immediately, so tests can call
+// runCleanups to simulate the real testing.TB running them once the test
+// ends, in last-registered-first-run order like testing.TB does.
+func (m *mockTB) Cleanup(f func()) {
+	m.cleanups = append(m.cleanups, f)
+}
+
+// runCleanups runs every registered cleanup, most-recently-registered
+// first, mirroring testing.TB's own LIFO order.
+func (m *mockTB) runCleanups() {
+	for i := len(m.cleanups) - 1; i >= 0; i-- {
+		m.cleanups[i]()
+	}
+	m.cleanups = nil
+}
+
+// runIsolated runs f in its own goroutine and waits for it to finish,
+// including via runtime.Goexit (which Fatalf relies on), without
+// terminating the calling test goroutine.
+func runIsolated(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	<-done
+}
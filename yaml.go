@@ -0,0 +1,14 @@
+package bumert
+
+// yamlUnmarshal is the decoder BeYAMLEqual uses to parse YAML. bumert has
+// no YAML dependency of its own, so this is nil until SetYAMLUnmarshaler
+// installs one.
+var yamlUnmarshal func(data []byte, v any) error
+
+// SetYAMLUnmarshaler installs the decoder BeYAMLEqual uses to parse YAML,
+// e.g. yaml.Unmarshal from gopkg.in/yaml.v3. bumert stays dependency-free
+// by default, so BeYAMLEqual panics until this is called once, typically
+// from an init function.
+func SetYAMLUnmarshaler(unmarshal func(data []byte, v any) error) {
+	yamlUnmarshal = unmarshal
+}